@@ -3,121 +3,423 @@
 package base58
 
 import (
-	"unicode/utf8"
+	"encoding/binary"
+	"math/bits"
 )
 
 const (
 	// Alphabet is the modified base58 alphabet.
-	Alphabet = "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz"
+	Alphabet = BTCAlphabet
+
+	// BTCAlphabet is the Bitcoin base58 alphabet.
+	BTCAlphabet = "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz"
+
+	// FlickrAlphabet is the Flickr base58 alphabet.
+	FlickrAlphabet = "123456789abcdefghijkmnopqrstuvwxyzABCDEFGHJKLMNPQRSTUVWXYZ"
+
+	// RippleAlphabet is the Ripple base58 alphabet.
+	RippleAlphabet = "rpshnaf39wBUDNEGHJKLM4PQRST7VWXYZ2bcdeCg65jkm8oFqi1tuvAxyz"
 
-	alphabetIdx0 = '1'
 	alphabetSize = len(Alphabet)
 
-	// i255 is a magic number represented symbol that does not exist in Alphabet.
+	// i255 is a magic number represented symbol that does not exist in alphabet.
 	i255 = 255
+
+	// limbDigits is a number of base58 digits packed into a single limb.
+	limbDigits = 5
+
+	// limbBase is 58^limbDigits, the largest power of 58 below 2^32,
+	// so limbs fit uint32 and products of limbs fit uint64.
+	limbBase = 58 * 58 * 58 * 58 * 58
+
+	// limbBytes is a number of bytes packed into a single limb.
+	limbBytes = 4
+
+	// limbBits is a number of bits in a single byte limb.
+	limbBits = 8 * limbBytes
+
+	// scratchLimbs is a number of limbs kept on the stack,
+	// longer inputs allocate the limbs on the heap.
+	scratchLimbs = 32
+
+	// encoded32Limbs is a number of limbs sufficient to encode 32 bytes.
+	encoded32Limbs = (32*138/100)/limbDigits + 1 // nolint: gomnd
 )
 
-// Decode decodes base58 encoded bytes.
+type (
+	// Encoding represents base58 encoding defined by 58-character alphabet.
+	// The first alphabet symbol encodes leading zero bytes.
+	Encoding struct {
+		encode [alphabetSize]byte
+		decode [256]byte
+	}
+)
+
+var (
+	// BTCEncoding is the base58 encoding with the Bitcoin alphabet,
+	// it is used by the package level functions.
+	BTCEncoding = mustNewEncoding(BTCAlphabet)
+
+	// FlickrEncoding is the base58 encoding with the Flickr alphabet.
+	FlickrEncoding = mustNewEncoding(FlickrAlphabet)
+
+	// RippleEncoding is the base58 encoding with the Ripple alphabet.
+	RippleEncoding = mustNewEncoding(RippleAlphabet)
+)
+
+// NewEncoding returns a new encoding defined by the given alphabet,
+// which must contain 58 unique ASCII printable symbols.
+func NewEncoding(alphabet string) (*Encoding, error) {
+	if len(alphabet) != alphabetSize {
+		return nil, ErrInvalidAlphabet()
+	}
+
+	enc := Encoding{}
+	for i := range enc.decode {
+		enc.decode[i] = i255
+	}
+
+	for i := 0; i < len(alphabet); i++ {
+		b := alphabet[i]
+		if b <= ' ' || b > '~' || enc.decode[b] != i255 {
+			return nil, ErrInvalidAlphabet()
+		}
+		enc.encode[i] = b
+		enc.decode[b] = byte(i)
+	}
+
+	return &enc, nil
+}
+
+// mustNewEncoding returns a new encoding or panics if the alphabet is invalid.
+func mustNewEncoding(alphabet string) *Encoding {
+	enc, err := NewEncoding(alphabet)
+	if err != nil {
+		panic(err)
+	}
+
+	return enc
+}
+
+// AppendDecode appends base58 decoded bytes to dst with the Bitcoin alphabet.
+func AppendDecode(dst, blob []byte) ([]byte, error) {
+	return BTCEncoding.AppendDecode(dst, blob)
+}
+
+// AppendEncode appends base58 encoded bytes to dst with the Bitcoin alphabet.
+func AppendEncode(dst, blob []byte) []byte {
+	return BTCEncoding.AppendEncode(dst, blob)
+}
+
+// AppendEncode32 appends base58 encoded 32-byte value to dst with the Bitcoin alphabet.
+func AppendEncode32(dst []byte, blob *[32]byte) []byte {
+	return BTCEncoding.AppendEncode32(dst, blob)
+}
+
+// Decode decodes base58 encoded bytes with the Bitcoin alphabet.
 func Decode(blob []byte) ([]byte, error) {
-	// nolint: gofmt, goimports
-	decodeTable := [256]byte{
-		255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255,
-		255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255,
-		255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255,
-		255,   0,   1,   2,   3,   4,   5,   6,   7,   8, 255, 255, 255, 255, 255, 255,
-		255,   9,  10,  11,  12,  13,  14,  15,  16, 255,  17,  18,  19,  20,  21, 255,
-		 22,  23,  24,  25,  26,  27,  28,  29,  30,  31,  32, 255, 255, 255, 255, 255,
-		255,  33,  34,  35,  36,  37,  38,  39,  40,  41,  42,  43, 255,  44,  45,  46,
-		 47,  48,  49,  50,  51,  52,  53,  54,  55,  56,  57, 255, 255, 255, 255, 255,
-		255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255,
-		255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255,
-		255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255,
-		255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255,
-		255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255,
-		255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255,
-		255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255,
-		255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255,
+	return BTCEncoding.Decode(blob)
+}
+
+// DecodeString decodes base58 encoded string to bytes with the Bitcoin alphabet.
+func DecodeString(s string) ([]byte, error) {
+	return BTCEncoding.DecodeString(s)
+}
+
+// Decode32 decodes base58 encoded 32-byte value into dst with the Bitcoin alphabet.
+func Decode32(dst *[32]byte, blob []byte) error {
+	return BTCEncoding.Decode32(dst, blob)
+}
+
+// DecodedLen returns the maximum length in bytes of n base58 symbols decoded,
+// the bound is reached by the input of leading zero symbols only.
+func DecodedLen(n int) int {
+	return n
+}
+
+// Encode encodes given bytes to base58 encoded bytes with the Bitcoin alphabet.
+func Encode(blob []byte) []byte {
+	return BTCEncoding.Encode(blob)
+}
+
+// EncodedLen returns the maximum length in symbols of n bytes base58 encoded.
+func EncodedLen(n int) int {
+	return n*138/100 + 1 // nolint: gomnd
+}
+
+// EncodeToString encodes given bytes to base58 encoded string with the Bitcoin alphabet.
+func EncodeToString(b []byte) string {
+	return BTCEncoding.EncodeToString(b)
+}
+
+// Validate checks the string is base58 encoded with the Bitcoin alphabet.
+func Validate(s string) error {
+	return BTCEncoding.Validate(s)
+}
+
+// Alphabet returns the alphabet of the encoding.
+func (c *Encoding) Alphabet() string {
+	return string(c.encode[:])
+}
+
+// AppendDecode appends base58 decoded bytes to dst and returns the extended buffer,
+// dst is returned unchanged if the input is malformed.
+//
+// The symbols are consumed by limbDigits at once and accumulated
+// into little-endian uint32 limbs of the decoded number,
+// so the carry loop runs over limbs instead of single bytes.
+func (c *Encoding) AppendDecode(dst, blob []byte) ([]byte, error) {
+	leadZeros, size := 0, len(blob)
+	for leadZeros < size && blob[leadZeros] == c.encode[0] {
+		leadZeros++
 	}
 
+	digits := blob[leadZeros:]
+	scratch := [scratchLimbs]uint32{}
+	limbs := scratch[:0]
 	// nolint: gomnd
-	capacity := utf8.RuneCount(blob)*733/1000 + 1 // log(58) / log(256)
-	output := make([]byte, capacity)
-	outputSize := capacity - 1
-	skipZeros, leadZeros := false, 0
-
-	for _, b := range blob {
-		// collect prefix zeros
-		if !skipZeros {
-			if b == alphabetIdx0 {
-				leadZeros++
-				continue // nolint: nlreturn
-			} else {
-				skipZeros = true
-			}
+	if need := (len(digits)*733/1000)/limbBytes + 1; need > scratchLimbs { // log(58) / log(256)
+		limbs = make([]uint32, 0, need)
+	}
+
+	head := len(digits) % limbDigits
+	if head == 0 {
+		head = limbDigits
+	}
+
+	for pos := 0; pos < len(digits); pos += head {
+		if pos > 0 {
+			head = limbDigits
 		}
 
-		carry := int(decodeTable[b])
-		if carry == i255 {
-			return nil, ErrUnknownFormat()
+		val, mul := uint64(0), uint64(1)
+		for i, b := range digits[pos : pos+head] {
+			idx := c.decode[b]
+			if idx == i255 {
+				return dst, &PositionError{Pos: leadZeros + pos + i, Char: b}
+			}
+			val = val*uint64(alphabetSize) + uint64(idx)
+			mul *= uint64(alphabetSize)
 		}
 
-		idx := capacity - 1
-		for ; idx > outputSize || carry != 0; idx-- {
-			carry += alphabetSize * int(output[idx])
-			output[idx] = byte(rune(carry) % 256) // nolint: gomnd
-			carry /= 256
+		carry := val
+		for i := range limbs {
+			carry += uint64(limbs[i]) * mul
+			limbs[i] = uint32(carry)
+			carry >>= limbBits
 		}
+		if carry > 0 {
+			limbs = append(limbs, uint32(carry))
+		}
+	}
 
-		outputSize = idx
+	// the most significant limb is never zero,
+	// so only its leading zero bytes are skipped
+	size = len(limbs) * limbBytes
+	if len(limbs) > 0 {
+		size -= bits.LeadingZeros32(limbs[len(limbs)-1]) / 8 // nolint: gomnd
 	}
 
-	res := make([]byte, leadZeros+(capacity-1-outputSize))
-	copy(res[leadZeros:], output[outputSize+1:])
+	dst, out := grow(dst, leadZeros+size)
+	for i := 0; i < leadZeros; i++ {
+		out[i] = 0
+	}
 
-	return res, nil
-}
+	out = out[leadZeros:]
+	for _, limb := range limbs {
+		for j := 0; j < limbBytes && size > 0; j++ {
+			size--
+			out[size] = byte(limb)
+			limb >>= 8 // nolint: gomnd
+		}
+	}
 
-// DecodeString decodes base58 encoded string to bytes.
-func DecodeString(s string) ([]byte, error) {
-	return Decode([]byte(s))
+	return dst, nil
 }
 
-// Encode encodes given bytes to base58 encoded bytes.
-func Encode(blob []byte) []byte {
+// AppendEncode appends base58 encoded bytes to dst and returns the extended buffer.
+//
+// The bytes are consumed by limbBytes at once and accumulated
+// into little-endian limbs of limbDigits base58 digits each,
+// so the carry loop runs over limbs instead of single digits.
+func (c *Encoding) AppendEncode(dst, blob []byte) []byte {
 	leadZeros, size := 0, len(blob)
 	for leadZeros < size && blob[leadZeros] == 0 {
 		leadZeros++
 	}
 
+	data := blob[leadZeros:]
+	scratch := [scratchLimbs]uint32{}
+	limbs := scratch[:0]
 	// nolint: gomnd
-	capacity := (size-leadZeros)*138/100 + 1 // log256 / log58
-	outputSize := capacity - 1
-
-	output := make([]byte, capacity)
-	for _, b := range blob[leadZeros:] {
-		idx := capacity - 1
-		for carry := int(b); idx > outputSize || carry != 0; idx-- {
-			carry += int(output[idx]) << 8 // nolint: gomnd
-			output[idx] = byte(carry % alphabetSize)
-			carry /= alphabetSize
+	if need := (len(data)*138/100)/limbDigits + 1; need > scratchLimbs { // log256 / log58
+		limbs = make([]uint32, 0, need)
+	}
+
+	head := len(data) % limbBytes
+	if head == 0 {
+		head = limbBytes
+	}
+
+	for pos := 0; pos < len(data); pos += head {
+		if pos > 0 {
+			head = limbBytes
+		}
+
+		carry := uint64(0)
+		for _, b := range data[pos : pos+head] {
+			carry = carry<<8 | uint64(b) // nolint: gomnd
+		}
+
+		shift := uint(8 * head) // nolint: gomnd
+		for i := range limbs {
+			carry += uint64(limbs[i]) << shift
+			limbs[i] = uint32(carry % limbBase)
+			carry /= limbBase
+		}
+		for carry > 0 {
+			limbs = append(limbs, uint32(carry%limbBase))
+			carry /= limbBase
 		}
-		outputSize = idx
 	}
 
-	encodeTable := []byte(Alphabet)
-	res := make([]byte, leadZeros+(capacity-1-outputSize))
-	for i := 0; i < leadZeros; i++ {
-		res[i] = alphabetIdx0
+	return c.appendLimbs(dst, leadZeros, limbs)
+}
+
+// AppendEncode32 appends base58 encoded 32-byte value to dst and returns the extended buffer.
+// It is the fixed-size fast path of AppendEncode for hashes and keys.
+func (c *Encoding) AppendEncode32(dst []byte, blob *[32]byte) []byte {
+	leadZeros := 0
+	for leadZeros < len(blob) && blob[leadZeros] == 0 {
+		leadZeros++
 	}
 
-	for i, n := range output[outputSize+1:] {
-		res[leadZeros+i] = encodeTable[n]
+	limbs, used := [encoded32Limbs]uint32{}, 0
+	for pos := leadZeros &^ (limbBytes - 1); pos < len(blob); pos += limbBytes {
+		carry := uint64(binary.BigEndian.Uint32(blob[pos:]))
+		for i := 0; i < used; i++ {
+			carry += uint64(limbs[i]) << limbBits
+			limbs[i] = uint32(carry % limbBase)
+			carry /= limbBase
+		}
+		for ; carry > 0; used++ {
+			limbs[used] = uint32(carry % limbBase)
+			carry /= limbBase
+		}
+	}
+
+	return c.appendLimbs(dst, leadZeros, limbs[:used])
+}
+
+// Decode decodes base58 encoded bytes.
+func (c *Encoding) Decode(blob []byte) ([]byte, error) {
+	res, err := c.AppendDecode(nil, blob)
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		res = []byte{}
+	}
+
+	return res, nil
+}
+
+// Decode32 decodes base58 encoded 32-byte value into dst,
+// the input must decode to exactly 32 bytes.
+func (c *Encoding) Decode32(dst *[32]byte, blob []byte) error {
+	if len(blob) > EncodedLen(len(dst)) {
+		return ErrInvalidLength()
+	}
+
+	buf := [32]byte{}
+	out, err := c.AppendDecode(buf[:0], blob)
+	if err != nil {
+		return err
+	}
+	if len(out) != len(dst) {
+		return ErrInvalidLength()
+	}
+
+	copy(dst[:], out)
+
+	return nil
+}
+
+// DecodeString decodes base58 encoded string to bytes.
+func (c *Encoding) DecodeString(s string) ([]byte, error) {
+	return c.Decode([]byte(s))
+}
+
+// Validate checks the string is base58 encoded without decoding it.
+// It reports the first symbol out of the alphabet as *PositionError.
+func (c *Encoding) Validate(s string) error {
+	for i := 0; i < len(s); i++ {
+		if c.decode[s[i]] == i255 {
+			return &PositionError{Pos: i, Char: s[i]}
+		}
+	}
+
+	return nil
+}
+
+// Encode encodes given bytes to base58 encoded bytes.
+func (c *Encoding) Encode(blob []byte) []byte {
+	res := c.AppendEncode(nil, blob)
+	if res == nil {
+		res = []byte{}
 	}
 
 	return res
 }
 
 // EncodeToString encodes given bytes to base58 encoded string.
-func EncodeToString(b []byte) string {
-	return string(Encode(b))
+func (c *Encoding) EncodeToString(b []byte) string {
+	return string(c.Encode(b))
+}
+
+// appendLimbs appends the leading zeros symbols
+// followed by base58 digits of little-endian limbs to dst.
+func (c *Encoding) appendLimbs(dst []byte, leadZeros int, limbs []uint32) []byte {
+	// the most significant limb is never zero,
+	// so only its leading zero digits are skipped
+	size := len(limbs) * limbDigits
+	if len(limbs) > 0 {
+		for top := uint64(limbs[len(limbs)-1]) * uint64(alphabetSize); top < limbBase; top *= uint64(alphabetSize) {
+			size--
+		}
+	}
+
+	dst, out := grow(dst, leadZeros+size)
+	for i := 0; i < leadZeros; i++ {
+		out[i] = c.encode[0]
+	}
+
+	out = out[leadZeros:]
+	for _, limb := range limbs {
+		for j := 0; j < limbDigits && size > 0; j++ {
+			size--
+			out[size] = c.encode[limb%uint32(alphabetSize)]
+			limb /= uint32(alphabetSize)
+		}
+	}
+
+	return dst
+}
+
+// grow extends the buffer by n bytes reallocating it if needed,
+// it returns the extended buffer and its n bytes tail.
+func grow(dst []byte, n int) ([]byte, []byte) {
+	size := len(dst)
+	if n == 0 {
+		return dst, nil
+	}
+	if cap(dst)-size < n {
+		buf := make([]byte, size, size+n)
+		copy(buf, dst)
+		dst = buf
+	}
+
+	dst = dst[:size+n]
+
+	return dst, dst[size:]
 }
@@ -0,0 +1,56 @@
+// Copyright © 2020-2021 The EVEN Solutions Developers Team
+
+//go:build go1.18
+// +build go1.18
+
+package base58_test
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+
+	. "github.com/platsko/go-kit/base58"
+)
+
+func Fuzz_Encode(f *testing.F) {
+	for _, c := range mockTestCaseEncode() {
+		f.Add([]byte(c.base))
+	}
+	f.Add([]byte{0, 0, 0, 1, 255})
+
+	f.Fuzz(func(t *testing.T, blob []byte) {
+		got, want := Encode(blob), mockEncode(blob)
+		if !reflect.DeepEqual(got, want) {
+			t.Fatalf("Encode() pass: %x got: %s | want: %s", blob, got, want)
+		}
+
+		decoded, err := Decode(got)
+		if err != nil {
+			t.Fatalf("Decode() error: %v | want: %v", err, nil)
+		}
+		if !bytes.Equal(decoded, blob) {
+			t.Fatalf("Decode() got: %x | want: %x", decoded, blob)
+		}
+	})
+}
+
+func Fuzz_Decode(f *testing.F) {
+	for _, c := range mockTestCaseDecode() {
+		f.Add([]byte(c.base))
+	}
+	for _, c := range mockTestCaseDecodeErr() {
+		f.Add([]byte(c.base))
+	}
+
+	f.Fuzz(func(t *testing.T, base58 []byte) {
+		want, wantErr := mockDecode(base58)
+		got, err := Decode(base58)
+		if (err != nil) != (wantErr != nil) {
+			t.Fatalf("Decode() pass: %q error: %v | want: %v", base58, err, wantErr)
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Fatalf("Decode() pass: %q got: %x | want: %x", base58, got, want)
+		}
+	})
+}
@@ -4,15 +4,20 @@ package base58_test
 
 import (
 	"encoding/hex"
+	stderrors "errors"
 	"reflect"
 	"testing"
 
 	. "github.com/platsko/go-kit/base58"
 	"github.com/platsko/go-kit/bytes"
+	"github.com/platsko/go-kit/errors"
 )
 
 const (
 	strBase58 = "TTe8GAjHDwbcnY1MYsBjNkBanp9GgyzPK8PxePH7zayyp"
+
+	// str32Base58 is base58 encoded SHA256 checksum of empty input.
+	str32Base58 = "GKot5hBsd81kMupNCXHaqbhv3huEbxAFMLnpcX2hniwn"
 )
 
 func Benchmark_Decode(b *testing.B) {
@@ -49,6 +54,91 @@ func Benchmark_EncodeToString(b *testing.B) {
 	}
 }
 
+func Benchmark_Decode_1KB(b *testing.B) {
+	base58 := Encode(bytes.RandBytes(1024))
+	b.SetBytes(int64(len(base58)))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := Decode(base58); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func Benchmark_Decode_1KB_Reference(b *testing.B) {
+	base58 := Encode(bytes.RandBytes(1024))
+	b.SetBytes(int64(len(base58)))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := mockDecode(base58); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func Benchmark_Encode_1KB(b *testing.B) {
+	blob := bytes.RandBytes(1024)
+	b.SetBytes(int64(len(blob)))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = Encode(blob)
+	}
+}
+
+func Benchmark_Encode_1KB_Reference(b *testing.B) {
+	blob := bytes.RandBytes(1024)
+	b.SetBytes(int64(len(blob)))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = mockEncode(blob)
+	}
+}
+
+func Benchmark_AppendDecode(b *testing.B) {
+	base58, buf := []byte(strBase58), make([]byte, 0, DecodedLen(len(strBase58)))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := AppendDecode(buf, base58); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func Benchmark_AppendEncode(b *testing.B) {
+	blob, buf := bytes.RandBytes(32), make([]byte, 0, EncodedLen(32))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = AppendEncode(buf, blob)
+	}
+}
+
+func Benchmark_AppendEncode32(b *testing.B) {
+	blob, buf := [32]byte{}, make([]byte, 0, EncodedLen(32))
+	copy(blob[:], bytes.RandBytes(32))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = AppendEncode32(buf, &blob)
+	}
+}
+
+func Benchmark_Decode32(b *testing.B) {
+	blob, dst := []byte(str32Base58), [32]byte{}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if err := Decode32(&dst, blob); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func Benchmark_Validate(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		if err := Validate(strBase58); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
 func Test_Decode(t *testing.T) {
 	t.Parallel()
 
@@ -226,3 +316,455 @@ func Test_EncodeToString(t *testing.T) {
 		})
 	}
 }
+
+func Test_Encode_Reference(t *testing.T) {
+	t.Parallel()
+
+	for size := 0; size <= 300; size++ {
+		blob := bytes.RandBytes(size)
+		if size > 2 {
+			blob[0], blob[1] = 0, 0 // cover leading zeros
+		}
+
+		want := mockEncode(blob)
+		got := Encode(blob)
+		if !reflect.DeepEqual(got, want) {
+			t.Fatalf("Encode() pass: %x got: %s | want: %s", blob, got, want)
+		}
+
+		decoded, err := Decode(got)
+		if err != nil {
+			t.Fatalf("Decode() error: %v | want: %v", err, nil)
+		}
+		if !reflect.DeepEqual(decoded, blob) {
+			t.Fatalf("Decode() got: %x | want: %x", decoded, blob)
+		}
+	}
+}
+
+func Test_Decode_Reference(t *testing.T) {
+	t.Parallel()
+
+	for size := 0; size <= 300; size++ {
+		base58 := make([]byte, size)
+		for i, b := range bytes.RandBytes(size) {
+			base58[i] = Alphabet[int(b)%len(Alphabet)]
+		}
+
+		want, wantErr := mockDecode(base58)
+		got, err := Decode(base58)
+		if (err != nil) != (wantErr != nil) {
+			t.Fatalf("Decode() error: %v | want: %v", err, wantErr)
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Fatalf("Decode() pass: %s got: %x | want: %x", base58, got, want)
+		}
+	}
+}
+
+func Test_NewEncoding(t *testing.T) {
+	t.Parallel()
+
+	tests := [7]struct {
+		name     string
+		alphabet string
+		wantErr  error
+	}{
+		{
+			name:     "BTC_OK",
+			alphabet: BTCAlphabet,
+		},
+		{
+			name:     "Flickr_OK",
+			alphabet: FlickrAlphabet,
+		},
+		{
+			name:     "Ripple_OK",
+			alphabet: RippleAlphabet,
+		},
+		{
+			name:     "short_ERR",
+			alphabet: BTCAlphabet[1:],
+			wantErr:  ErrInvalidAlphabet(),
+		},
+		{
+			name:     "duplicate_ERR",
+			alphabet: "1" + BTCAlphabet[:len(BTCAlphabet)-1],
+			wantErr:  ErrInvalidAlphabet(),
+		},
+		{
+			name:     "space_ERR",
+			alphabet: " " + BTCAlphabet[1:],
+			wantErr:  ErrInvalidAlphabet(),
+		},
+		{
+			name:     "non_ASCII_ERR",
+			alphabet: "\xff" + BTCAlphabet[1:],
+			wantErr:  ErrInvalidAlphabet(),
+		},
+	}
+
+	for idx := range tests {
+		test := tests[idx]
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			enc, err := NewEncoding(test.alphabet)
+			if !errors.Is(err, test.wantErr) {
+				t.Errorf("NewEncoding() error: %v | want: %v", err, test.wantErr)
+				return
+			}
+			if test.wantErr != nil {
+				return
+			}
+			if got := enc.Alphabet(); got != test.alphabet {
+				t.Errorf("Alphabet() got: %v | want: %v", got, test.alphabet)
+			}
+		})
+	}
+}
+
+func Test_Encoding_EncodeToString(t *testing.T) {
+	t.Parallel()
+
+	// the Ripple account zero: version byte, zero account id and checksum
+	account := make([]byte, 21)
+	checksum := Checksum(account)
+	account = append(account, checksum[:]...)
+
+	tests := [4]struct {
+		name string
+		enc  *Encoding
+		blob []byte
+		want string
+	}{
+		{
+			name: "BTC_OK",
+			enc:  BTCEncoding,
+			blob: []byte("Hello World!"),
+			want: "2NEpo7TZRRrLZSi2U",
+		},
+		{
+			name: "Flickr_OK",
+			enc:  FlickrEncoding,
+			blob: []byte("Hello World!"),
+			want: "2nePN7syqqRkyrH2t",
+		},
+		{
+			name: "Ripple_OK",
+			enc:  RippleEncoding,
+			blob: []byte("Hello World!"),
+			want: "p4NFofTZRRiLZS5p7",
+		},
+		{
+			name: "Ripple_account_zero_OK",
+			enc:  RippleEncoding,
+			blob: account,
+			want: "rrrrrrrrrrrrrrrrrrrrrhoLvTp",
+		},
+	}
+
+	for idx := range tests {
+		test := tests[idx]
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := test.enc.EncodeToString(test.blob); got != test.want {
+				t.Errorf("EncodeToString() got: %v | want: %v", got, test.want)
+			}
+
+			got, err := test.enc.DecodeString(test.want)
+			if err != nil {
+				t.Errorf("DecodeString() error: %v | want: %v", err, nil)
+				return
+			}
+			if !reflect.DeepEqual(got, test.blob) {
+				t.Errorf("DecodeString() got: %#v | want: %#v", got, test.blob)
+			}
+		})
+	}
+}
+
+func Test_Encoding_Decode(t *testing.T) {
+	t.Parallel()
+
+	tests := [3]struct {
+		name    string
+		enc     *Encoding
+		base    string
+		wantErr error
+	}{
+		{
+			name:    "Ripple_unknown_symbol_ERR",
+			enc:     RippleEncoding,
+			base:    "1l",
+			wantErr: ErrUnknownFormat(),
+		},
+		{
+			name:    "Flickr_unknown_symbol_ERR",
+			enc:     FlickrEncoding,
+			base:    "1l",
+			wantErr: ErrUnknownFormat(),
+		},
+		{
+			name: "BTC_OK",
+			enc:  BTCEncoding,
+			base: "1z",
+		},
+	}
+
+	for idx := range tests {
+		test := tests[idx]
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			if _, err := test.enc.DecodeString(test.base); !errors.Is(err, test.wantErr) {
+				t.Errorf("DecodeString() error: %v | want: %v", err, test.wantErr)
+			}
+		})
+	}
+}
+
+func Test_Decode_PositionError(t *testing.T) {
+	t.Parallel()
+
+	tests := [4]struct {
+		name    string
+		base    string
+		wantPos int
+		wantChr byte
+		wantMsg string
+	}{
+		{
+			name:    "symbol_ERR",
+			base:    "12l3",
+			wantPos: 2,
+			wantChr: 'l',
+			wantMsg: ErrUnknownFormatMsg + errors.GetDelimiter() + "symbol 'l' at position 2",
+		},
+		{
+			name:    "after_leading_zeros_ERR",
+			base:    "1110",
+			wantPos: 3,
+			wantChr: '0',
+			wantMsg: ErrUnknownFormatMsg + errors.GetDelimiter() + "symbol '0' at position 3",
+		},
+		{
+			name:    "non_ASCII_ERR",
+			base:    "2é",
+			wantPos: 1,
+			wantChr: 0xc3,
+			wantMsg: ErrUnknownFormatMsg + errors.GetDelimiter() + "non-ASCII byte 0xc3 at position 1",
+		},
+		{
+			name:    "far_limb_ERR",
+			base:    strBase58 + "I",
+			wantPos: len(strBase58),
+			wantChr: 'I',
+			wantMsg: ErrUnknownFormatMsg + errors.GetDelimiter() + "symbol 'I' at position 45",
+		},
+	}
+
+	for idx := range tests {
+		test := tests[idx]
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			for _, err := range [2]error{Validate(test.base), mockDecodeStringErr(test.base)} {
+				if !errors.Is(err, ErrUnknownFormat()) {
+					t.Errorf("DecodeString() error: %v | want: %v", err, ErrUnknownFormat())
+					return
+				}
+				posErr, ok := err.(*PositionError)
+				if !ok {
+					t.Errorf("DecodeString() error: %#v | want: %T", err, posErr)
+					return
+				}
+				var wrapped *PositionError
+				if !stderrors.As(errors.WrapErr("base58", err), &wrapped) || wrapped != posErr {
+					t.Errorf("As() got: %#v | want: %#v", wrapped, posErr)
+				}
+				if posErr.Pos != test.wantPos || posErr.Char != test.wantChr {
+					t.Errorf("DecodeString() error: %v %#x | want: %v %#x", posErr.Pos, posErr.Char, test.wantPos, test.wantChr)
+				}
+				if got := posErr.Error(); got != test.wantMsg {
+					t.Errorf("Error() got: %v | want: %v", got, test.wantMsg)
+				}
+			}
+		})
+	}
+}
+
+func Test_Validate(t *testing.T) {
+	t.Parallel()
+
+	for _, c := range mockTestCaseDecode() {
+		if err := Validate(c.base); err != nil {
+			t.Errorf("Validate() error: %v | want: %v", err, nil)
+		}
+	}
+	for _, c := range mockTestCaseDecodeErr() {
+		if err := Validate(c.base); !errors.Is(err, ErrUnknownFormat()) {
+			t.Errorf("Validate() error: %v | want: %v", err, ErrUnknownFormat())
+		}
+	}
+	if err := FlickrEncoding.Validate("0"); !errors.Is(err, ErrUnknownFormat()) {
+		t.Errorf("Validate() error: %v | want: %v", err, ErrUnknownFormat())
+	}
+}
+
+func Test_Validate_Allocs(t *testing.T) {
+	allocs := testing.AllocsPerRun(100, func() {
+		_ = Validate(strBase58)
+	})
+	if allocs != 0 {
+		t.Errorf("Validate() allocs: %v | want: %v", allocs, 0)
+	}
+}
+
+func Test_AppendEncode(t *testing.T) {
+	t.Parallel()
+
+	prefix := []byte("prefix:")
+	for size := 0; size <= 300; size++ {
+		blob := bytes.RandBytes(size)
+		if size > 2 {
+			blob[0], blob[1] = 0, 0
+		}
+
+		want := Encode(blob)
+		if len(want) > EncodedLen(size) {
+			t.Fatalf("EncodedLen() got: %v | want: >= %v", EncodedLen(size), len(want))
+		}
+
+		got := AppendEncode(append([]byte{}, prefix...), blob)
+		if !reflect.DeepEqual(got, append(append([]byte{}, prefix...), want...)) {
+			t.Fatalf("AppendEncode() got: %s | want: %s%s", got, prefix, want)
+		}
+
+		decoded, err := AppendDecode(append([]byte{}, prefix...), want)
+		if err != nil {
+			t.Fatalf("AppendDecode() error: %v | want: %v", err, nil)
+		}
+		if !reflect.DeepEqual(decoded, append(append([]byte{}, prefix...), blob...)) {
+			t.Fatalf("AppendDecode() got: %x | want: %x%x", decoded, prefix, blob)
+		}
+		if len(blob) > DecodedLen(len(want)) {
+			t.Fatalf("DecodedLen() got: %v | want: >= %v", DecodedLen(len(want)), len(blob))
+		}
+	}
+}
+
+func Test_AppendDecode_Invalid(t *testing.T) {
+	t.Parallel()
+
+	dst := []byte("prefix:")
+	got, err := AppendDecode(dst, []byte("1z0"))
+	if !errors.Is(err, ErrUnknownFormat()) {
+		t.Errorf("AppendDecode() error: %v | want: %v", err, ErrUnknownFormat())
+	}
+	if !reflect.DeepEqual(got, dst) {
+		t.Errorf("AppendDecode() got: %s | want: %s", got, dst)
+	}
+}
+
+func Test_AppendEncode32(t *testing.T) {
+	t.Parallel()
+
+	blobs := make([][32]byte, 0, 35)
+	blobs = append(blobs, [32]byte{})
+	for zeros := 0; zeros < 32; zeros++ {
+		blob := [32]byte{}
+		copy(blob[zeros:], bytes.RandBytes(32-zeros))
+		blob[zeros] |= 1
+		blobs = append(blobs, blob)
+	}
+	full := [32]byte{}
+	for i := range full {
+		full[i] = 0xff
+	}
+	blobs = append(blobs, full)
+
+	for idx := range blobs {
+		blob := blobs[idx]
+		want := Encode(blob[:])
+		if got := AppendEncode32(nil, &blob); !reflect.DeepEqual(got, want) {
+			t.Errorf("AppendEncode32() got: %s | want: %s", got, want)
+		}
+
+		got := [32]byte{}
+		if err := Decode32(&got, want); err != nil {
+			t.Errorf("Decode32() error: %v | want: %v", err, nil)
+			continue
+		}
+		if got != blob {
+			t.Errorf("Decode32() got: %x | want: %x", got, blob)
+		}
+	}
+}
+
+func Test_Decode32_Invalid(t *testing.T) {
+	t.Parallel()
+
+	tests := [4]struct {
+		name    string
+		base    string
+		wantErr error
+	}{
+		{
+			name:    "short_ERR",
+			base:    str32Base58[:40],
+			wantErr: ErrInvalidLength(),
+		},
+		{
+			name:    "long_ERR",
+			base:    strBase58,
+			wantErr: ErrInvalidLength(),
+		},
+		{
+			name:    "overflow_ERR",
+			base:    "zzzzzzzzzzzzzzzzzzzzzzzzzzzzzzzzzzzzzzzzzzzz",
+			wantErr: ErrInvalidLength(),
+		},
+		{
+			name:    "unknown_symbol_ERR",
+			base:    "0" + str32Base58[1:],
+			wantErr: ErrUnknownFormat(),
+		},
+	}
+
+	for idx := range tests {
+		test := tests[idx]
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			dst := [32]byte{}
+			if err := Decode32(&dst, []byte(test.base)); !errors.Is(err, test.wantErr) {
+				t.Errorf("Decode32() error: %v | want: %v", err, test.wantErr)
+			}
+		})
+	}
+}
+
+func Test_Append_Allocs(t *testing.T) {
+	blob, base58 := [32]byte{}, []byte(str32Base58)
+	copy(blob[:], bytes.RandBytes(32))
+	buf := make([]byte, 0, EncodedLen(len(blob)))
+
+	tests := [4]struct {
+		name string
+		fn   func()
+	}{
+		{name: "AppendEncode", fn: func() { _ = AppendEncode(buf, blob[:]) }},
+		{name: "AppendEncode32", fn: func() { _ = AppendEncode32(buf, &blob) }},
+		{name: "AppendDecode", fn: func() { _, _ = AppendDecode(buf, base58) }},
+		{name: "Decode32", fn: func() { _ = Decode32(&blob, base58) }},
+	}
+
+	for idx := range tests {
+		test := tests[idx]
+		if allocs := testing.AllocsPerRun(100, test.fn); allocs != 0 {
+			t.Errorf("%v() allocs: %v | want: %v", test.name, allocs, 0)
+		}
+	}
+}
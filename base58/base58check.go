@@ -12,46 +12,46 @@ const (
 
 	// VersionSize is a size of version in bytes.
 	VersionSize = 1
+
+	// MaxVersionSize is a maximum size of multi-byte version in bytes.
+	MaxVersionSize = 4
+)
+
+type (
+	// ChecksumFunc calculates the checksum appended to the encoded data,
+	// the checksum size is the length of the returned slice and
+	// it has to be the same for any data.
+	ChecksumFunc func([]byte) []byte
 )
 
 // CheckEncode prepends a version byte and appends a four byte checksum.
 func CheckEncode(b []byte, ver byte) string {
-	blob := make([]byte, 0, VersionSize+len(b)+ChecksumSize)
-	blob = append(blob, ver)
-	blob = append(blob, b...)
-
-	checksum := Checksum(blob)
-	blob = append(blob, checksum[:]...)
+	s, _ := BTCEncoding.CheckEncode(b, []byte{ver}, nil) // never returns an error
 
-	return EncodeToString(blob)
+	return s
 }
 
 // CheckDecode decodes a string that was encoded with CheckEncode and verifies the checksum.
 func CheckDecode(blob []byte) ([]byte, byte, error) {
-	decoded, err := Decode(blob)
+	payload, ver, err := BTCEncoding.CheckDecode(blob, VersionSize, nil)
 	if err != nil {
 		return nil, 0, err
 	}
 
-	if len(decoded) < VersionSize+ChecksumSize {
-		return nil, 0, ErrInvalidFormat()
-	}
-
-	size := len(decoded) - ChecksumSize
-	checksum := [ChecksumSize]byte{}
-	copy(checksum[:], decoded[size:])
-
-	if Checksum(decoded[:size]) != checksum {
-		return nil, 0, ErrChecksumMismatch()
-	}
-
-	payload := decoded[VersionSize:size]
-	result := make([]byte, 0, len(payload))
-	result = append(result, payload...)
+	return payload, ver[0], nil
+}
 
-	version := decoded[0]
+// CheckEncodeVersion prepends a multi-byte version and appends a checksum
+// calculated by the checksum func, DoubleSHA256 is used if it is nil.
+// The result is encoded with the Bitcoin alphabet.
+func CheckEncodeVersion(b, ver []byte, checksum ChecksumFunc) (string, error) {
+	return BTCEncoding.CheckEncode(b, ver, checksum)
+}
 
-	return result, version, nil
+// CheckDecodeVersion decodes a string that was encoded with CheckEncodeVersion
+// and verifies the checksum, the version size has to be known by the caller.
+func CheckDecodeVersion(blob []byte, verSize int, checksum ChecksumFunc) ([]byte, []byte, error) {
+	return BTCEncoding.CheckDecode(blob, verSize, checksum)
 }
 
 // Checksum returns first of ChecksumSize bytes
@@ -64,3 +64,65 @@ func Checksum(blob []byte) (checksum [ChecksumSize]byte) {
 
 	return checksum
 }
+
+// DoubleSHA256 implements ChecksumFunc with Checksum.
+func DoubleSHA256(blob []byte) []byte {
+	checksum := Checksum(blob)
+
+	return checksum[:]
+}
+
+// CheckEncode prepends a multi-byte version and appends a checksum
+// calculated by the checksum func, DoubleSHA256 is used if it is nil.
+func (c *Encoding) CheckEncode(b, ver []byte, checksum ChecksumFunc) (string, error) {
+	if len(ver) < VersionSize || len(ver) > MaxVersionSize {
+		return "", ErrInvalidVersionLength()
+	}
+
+	if checksum == nil {
+		checksum = DoubleSHA256
+	}
+
+	blob := make([]byte, 0, len(ver)+len(b)+ChecksumSize)
+	blob = append(blob, ver...)
+	blob = append(blob, b...)
+	blob = append(blob, checksum(blob)...)
+
+	return c.EncodeToString(blob), nil
+}
+
+// CheckDecode decodes a string that was encoded with CheckEncode
+// and verifies the checksum calculated by the checksum func,
+// DoubleSHA256 is used if it is nil.
+func (c *Encoding) CheckDecode(blob []byte, verSize int, checksum ChecksumFunc) ([]byte, []byte, error) {
+	if verSize < VersionSize || verSize > MaxVersionSize {
+		return nil, nil, ErrInvalidVersionLength()
+	}
+
+	if checksum == nil {
+		checksum = DoubleSHA256
+	}
+
+	decoded, err := c.Decode(blob)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	sumSize := len(checksum(nil))
+	if len(decoded) < verSize+sumSize {
+		return nil, nil, ErrInvalidFormat()
+	}
+
+	size := len(decoded) - sumSize
+	if string(checksum(decoded[:size])) != string(decoded[size:]) {
+		return nil, nil, ErrChecksumMismatch()
+	}
+
+	version := make([]byte, verSize)
+	copy(version, decoded[:verSize])
+
+	payload := make([]byte, 0, size-verSize)
+	payload = append(payload, decoded[verSize:size]...)
+
+	return payload, version, nil
+}
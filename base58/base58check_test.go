@@ -4,6 +4,7 @@ package base58_test
 
 import (
 	"crypto/sha256"
+	"encoding/hex"
 	"reflect"
 	"testing"
 
@@ -181,3 +182,168 @@ func Test_Checksum(t *testing.T) {
 		})
 	}
 }
+
+func Test_CheckDecodeVersion(t *testing.T) {
+	t.Parallel()
+
+	// BIP-32 test vector 1 master extended public key
+	xpub := "xpub661MyMwAqRbcFtXgS5sYJABqqG9YLmC4Q1Rdap9gSE8NqtwybGhePY2gZ29ESFjqJoCu1Rupje8YtGqsefD265TMg7usUDFdp6W1EGMcet8"
+
+	tests := [6]struct {
+		name     string
+		blob     string
+		verSize  int
+		checksum ChecksumFunc
+		wantVer  string
+		wantSize int
+		wantErr  error
+	}{
+		{
+			name:     "xpub_OK",
+			blob:     xpub,
+			verSize:  4,
+			wantVer:  "0488b21e",
+			wantSize: 74,
+		},
+		{
+			name:     "xpub_DoubleSHA256_OK",
+			blob:     xpub,
+			verSize:  4,
+			checksum: DoubleSHA256,
+			wantVer:  "0488b21e",
+			wantSize: 74,
+		},
+		{
+			name:     "checksum_func_ERR",
+			blob:     xpub,
+			verSize:  4,
+			checksum: mockChecksumSHA256,
+			wantErr:  ErrChecksumMismatch(),
+		},
+		{
+			name:    "zero_version_length_ERR",
+			blob:    xpub,
+			wantErr: ErrInvalidVersionLength(),
+		},
+		{
+			name:    "long_version_length_ERR",
+			blob:    xpub,
+			verSize: MaxVersionSize + 1,
+			wantErr: ErrInvalidVersionLength(),
+		},
+		{
+			name:    "charset_ERR",
+			blob:    "0" + xpub[1:],
+			verSize: 4,
+			wantErr: ErrUnknownFormat(),
+		},
+	}
+
+	for idx := range tests {
+		test := tests[idx]
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, ver, err := CheckDecodeVersion([]byte(test.blob), test.verSize, test.checksum)
+			if !errors.Is(err, test.wantErr) {
+				t.Errorf("CheckDecodeVersion() error: %v | want: %v", err, test.wantErr)
+				return
+			}
+			if test.wantErr != nil {
+				return
+			}
+			if hex.EncodeToString(ver) != test.wantVer || len(got) != test.wantSize {
+				t.Errorf("CheckDecodeVersion() got ver: %x size: %v | want: %v size: %v", ver, len(got), test.wantVer, test.wantSize)
+			}
+
+			str, err := CheckEncodeVersion(got, ver, test.checksum)
+			if err != nil {
+				t.Errorf("CheckEncodeVersion() error: %v | want: %v", err, nil)
+				return
+			}
+			if str != test.blob {
+				t.Errorf("CheckEncodeVersion() got: %v | want: %v", str, test.blob)
+			}
+		})
+	}
+}
+
+func Test_CheckEncodeVersion(t *testing.T) {
+	t.Parallel()
+
+	tests := [5]struct {
+		name     string
+		ver      []byte
+		checksum ChecksumFunc
+		wantErr  error
+	}{
+		{
+			name: "single_byte_OK",
+			ver:  []byte{20},
+		},
+		{
+			name:     "two_bytes_custom_checksum_OK",
+			ver:      []byte{0x1c, 0xb8},
+			checksum: mockChecksumSHA256,
+		},
+		{
+			name: "four_bytes_OK",
+			ver:  []byte{0x04, 0x88, 0xb2, 0x1e},
+		},
+		{
+			name:    "empty_version_ERR",
+			wantErr: ErrInvalidVersionLength(),
+		},
+		{
+			name:    "long_version_ERR",
+			ver:     make([]byte, MaxVersionSize+1),
+			wantErr: ErrInvalidVersionLength(),
+		},
+	}
+
+	for idx := range tests {
+		test := tests[idx]
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			blob := bytes.RandBytes(32)
+			str, err := CheckEncodeVersion(blob, test.ver, test.checksum)
+			if !errors.Is(err, test.wantErr) {
+				t.Errorf("CheckEncodeVersion() error: %v | want: %v", err, test.wantErr)
+				return
+			}
+			if test.wantErr != nil {
+				return
+			}
+
+			got, ver, err := CheckDecodeVersion([]byte(str), len(test.ver), test.checksum)
+			if err != nil {
+				t.Errorf("CheckDecodeVersion() error: %v | want: %v", err, nil)
+				return
+			}
+			if !reflect.DeepEqual(got, blob) || !reflect.DeepEqual(ver, test.ver) {
+				t.Errorf("CheckDecodeVersion() got: %x ver: %x | want: %x ver: %x", got, ver, blob, test.ver)
+			}
+			if len(test.ver) == VersionSize && str != CheckEncode(blob, test.ver[0]) {
+				t.Errorf("CheckEncodeVersion() got: %v | want: %v", str, CheckEncode(blob, test.ver[0]))
+			}
+		})
+	}
+}
+
+func Test_Encoding_CheckEncode(t *testing.T) {
+	t.Parallel()
+
+	// the Ripple account zero: version byte and zero account id
+	str, err := RippleEncoding.CheckEncode(make([]byte, 20), []byte{0}, nil)
+	if err != nil {
+		t.Fatalf("CheckEncode() error: %v | want: %v", err, nil)
+	}
+	if want := "rrrrrrrrrrrrrrrrrrrrrhoLvTp"; str != want {
+		t.Errorf("CheckEncode() got: %v | want: %v", str, want)
+	}
+
+	if _, _, err = BTCEncoding.CheckDecode([]byte(str), VersionSize, nil); !errors.Is(err, ErrChecksumMismatch()) {
+		t.Errorf("CheckDecode() error: %v | want: %v", err, ErrChecksumMismatch())
+	}
+}
@@ -3,29 +3,74 @@
 package base58
 
 import (
+	"strconv"
+	"unicode/utf8"
+
 	"github.com/platsko/go-kit/errors"
 )
 
 const (
-	ErrChecksumMismatchMsg = "checksum mismatch"
-	ErrInvalidFormatMsg    = "invalid format"
-	ErrUnknownFormatMsg    = "unknown format"
+	ErrChecksumMismatchMsg     = "checksum mismatch"
+	ErrInvalidAlphabetMsg      = "invalid alphabet"
+	ErrInvalidFormatMsg        = "invalid format"
+	ErrInvalidLengthMsg        = "invalid length"
+	ErrInvalidVersionLengthMsg = "invalid version length"
+	ErrUnknownFormatMsg        = "unknown format"
 )
 
 var (
-	errChecksumMismatch = errors.New(ErrChecksumMismatchMsg)
-	errInvalidFormat    = errors.New(ErrInvalidFormatMsg)
-	errUnknownFormat    = errors.New(ErrUnknownFormatMsg)
+	errChecksumMismatch     = errors.New(ErrChecksumMismatchMsg)
+	errInvalidAlphabet      = errors.New(ErrInvalidAlphabetMsg)
+	errInvalidFormat        = errors.New(ErrInvalidFormatMsg)
+	errInvalidLength        = errors.New(ErrInvalidLengthMsg)
+	errInvalidVersionLength = errors.New(ErrInvalidVersionLengthMsg)
+	errUnknownFormat        = errors.New(ErrUnknownFormatMsg)
+)
+
+type (
+	// PositionError reports the byte out of the alphabet at the offset of the input,
+	// it implements errors.Wrapper interface and unwraps to ErrUnknownFormat.
+	PositionError struct {
+		Pos  int
+		Char byte
+	}
 )
 
+// Error implements error Wrapper interface.
+func (e *PositionError) Error() string {
+	symbol := "non-ASCII byte 0x" + strconv.FormatUint(uint64(e.Char), 16) // nolint: gomnd
+	if e.Char < utf8.RuneSelf {
+		symbol = "symbol " + strconv.QuoteRune(rune(e.Char))
+	}
+
+	return ErrUnknownFormatMsg + errors.GetDelimiter() + symbol + " at position " + strconv.Itoa(e.Pos)
+}
+
+// Unwrap implements error Wrapper interface.
+func (e *PositionError) Unwrap() error {
+	return errUnknownFormat
+}
+
 func ErrChecksumMismatch() error {
 	return errChecksumMismatch
 }
 
+func ErrInvalidAlphabet() error {
+	return errInvalidAlphabet
+}
+
 func ErrInvalidFormat() error {
 	return errInvalidFormat
 }
 
+func ErrInvalidLength() error {
+	return errInvalidLength
+}
+
+func ErrInvalidVersionLength() error {
+	return errInvalidVersionLength
+}
+
 func ErrUnknownFormat() error {
 	return errUnknownFormat
 }
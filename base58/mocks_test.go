@@ -3,7 +3,9 @@
 package base58_test
 
 import (
+	"crypto/sha256"
 	"strings"
+	"unicode/utf8"
 
 	. "github.com/platsko/go-kit/base58"
 )
@@ -174,3 +176,97 @@ func mockTestCaseEncode() [11]testMock {
 		},
 	}
 }
+
+// mockDecode is the reference byte by byte base58 decoder
+// used to check the limb based implementation output.
+func mockDecode(blob []byte) ([]byte, error) {
+	decodeTable := [256]byte{}
+	for i := range decodeTable {
+		decodeTable[i] = 255
+	}
+	for i := 0; i < len(Alphabet); i++ {
+		decodeTable[Alphabet[i]] = byte(i)
+	}
+
+	capacity := utf8.RuneCount(blob)*733/1000 + 1 // log(58) / log(256)
+	output := make([]byte, capacity)
+	outputSize := capacity - 1
+	skipZeros, leadZeros := false, 0
+
+	for _, b := range blob {
+		if !skipZeros {
+			if b == '1' {
+				leadZeros++
+				continue
+			}
+			skipZeros = true
+		}
+
+		carry := int(decodeTable[b])
+		if carry == 255 {
+			return nil, ErrUnknownFormat()
+		}
+
+		idx := capacity - 1
+		for ; idx > outputSize || carry != 0; idx-- {
+			carry += len(Alphabet) * int(output[idx])
+			output[idx] = byte(carry % 256)
+			carry /= 256
+		}
+
+		outputSize = idx
+	}
+
+	res := make([]byte, leadZeros+(capacity-1-outputSize))
+	copy(res[leadZeros:], output[outputSize+1:])
+
+	return res, nil
+}
+
+// mockEncode is the reference byte by byte base58 encoder
+// used to check the limb based implementation output.
+func mockEncode(blob []byte) []byte {
+	leadZeros, size := 0, len(blob)
+	for leadZeros < size && blob[leadZeros] == 0 {
+		leadZeros++
+	}
+
+	capacity := (size-leadZeros)*138/100 + 1 // log256 / log58
+	outputSize := capacity - 1
+
+	output := make([]byte, capacity)
+	for _, b := range blob[leadZeros:] {
+		idx := capacity - 1
+		for carry := int(b); idx > outputSize || carry != 0; idx-- {
+			carry += int(output[idx]) << 8
+			output[idx] = byte(carry % len(Alphabet))
+			carry /= len(Alphabet)
+		}
+		outputSize = idx
+	}
+
+	res := make([]byte, leadZeros+(capacity-1-outputSize))
+	for i := 0; i < leadZeros; i++ {
+		res[i] = '1'
+	}
+
+	for i, n := range output[outputSize+1:] {
+		res[leadZeros+i] = Alphabet[n]
+	}
+
+	return res
+}
+
+// mockChecksumSHA256 implements ChecksumFunc with two bytes of single SHA256.
+func mockChecksumSHA256(blob []byte) []byte {
+	h256 := sha256.Sum256(blob)
+
+	return h256[:2]
+}
+
+// mockDecodeStringErr returns an error of decoding the string.
+func mockDecodeStringErr(s string) error {
+	_, err := DecodeString(s)
+
+	return err
+}
@@ -0,0 +1,155 @@
+// Copyright © 2020-2021 The EVEN Solutions Developers Team
+
+package base58
+
+import (
+	"io"
+)
+
+const (
+	// StreamChunkSize is a size in bytes of the input chunk
+	// encoded to a single frame by the stream encoder.
+	StreamChunkSize = 256
+
+	// streamPrefixSize is a number of alphabet symbols of the frame prefix,
+	// two base58 digits hold lengths up to 58*58-1 symbols.
+	streamPrefixSize = 2
+
+	// streamFrameSize is the maximum size of the encoded chunk.
+	streamFrameSize = StreamChunkSize*138/100 + 1 // nolint: gomnd
+)
+
+type (
+	// encoder implements io.WriteCloser
+	// over the stream of base58 encoded frames.
+	encoder struct {
+		enc   *Encoding
+		w     io.Writer
+		buf   [StreamChunkSize]byte
+		frame [streamPrefixSize + streamFrameSize]byte
+		n     int
+		err   error
+	}
+
+	// decoder implements io.Reader
+	// over the stream of base58 encoded frames.
+	decoder struct {
+		enc   *Encoding
+		r     io.Reader
+		buf   [StreamChunkSize]byte
+		frame [streamFrameSize]byte
+		out   []byte
+		err   error
+	}
+)
+
+// NewEncoder returns a new base58 stream encoder.
+// Data written to the returned writer is split into chunks of StreamChunkSize
+// bytes, each chunk is encoded to a frame prefixed with the length
+// of the encoded chunk written as two base58 digits of the same alphabet,
+// so the output contains the alphabet symbols only. The caller must Close
+// the returned encoder to flush the last partially filled chunk.
+func NewEncoder(enc *Encoding, w io.Writer) io.WriteCloser {
+	return &encoder{enc: enc, w: w}
+}
+
+// NewDecoder returns a new base58 stream decoder
+// which reads length-prefixed frames produced by the stream encoder.
+// The stream truncated inside of a frame fails with io.ErrUnexpectedEOF.
+func NewDecoder(enc *Encoding, r io.Reader) io.Reader {
+	return &decoder{enc: enc, r: r}
+}
+
+// Close implements io.Closer interface.
+// It flushes any partially filled chunk.
+func (c *encoder) Close() error {
+	if c.err == nil && c.n > 0 {
+		c.flush()
+	}
+
+	return c.err
+}
+
+// Write implements io.Writer interface.
+func (c *encoder) Write(p []byte) (int, error) {
+	written := 0
+	for c.err == nil && len(p) > 0 {
+		n := copy(c.buf[c.n:], p)
+		c.n += n
+		p = p[n:]
+		written += n
+
+		if c.n == StreamChunkSize {
+			c.flush()
+		}
+	}
+
+	return written, c.err
+}
+
+// flush writes the length-prefixed frame of buffered chunk.
+func (c *encoder) flush() {
+	frame := c.enc.AppendEncode(c.frame[:streamPrefixSize], c.buf[:c.n])
+	size := len(frame) - streamPrefixSize
+	frame[0], frame[1] = c.enc.encode[size/alphabetSize], c.enc.encode[size%alphabetSize]
+
+	_, c.err = c.w.Write(frame)
+	c.n = 0
+}
+
+// Read implements io.Reader interface.
+func (c *decoder) Read(p []byte) (int, error) {
+	for len(c.out) == 0 {
+		if c.err != nil {
+			return 0, c.err
+		}
+		c.readFrame()
+	}
+
+	n := copy(p, c.out)
+	c.out = c.out[n:]
+
+	return n, nil
+}
+
+// readFrame reads and decodes the next frame,
+// io.EOF is kept only if the stream ends on the frame boundary.
+func (c *decoder) readFrame() {
+	prefix := c.frame[:streamPrefixSize]
+	if _, err := io.ReadFull(c.r, prefix); err != nil {
+		c.err = err
+		return
+	}
+
+	hi, lo := c.enc.decode[prefix[0]], c.enc.decode[prefix[1]]
+	if hi == i255 || lo == i255 {
+		c.err = ErrInvalidFormat()
+		return
+	}
+
+	size := int(hi)*alphabetSize + int(lo)
+	if size == 0 || size > streamFrameSize {
+		c.err = ErrInvalidFormat()
+		return
+	}
+
+	frame := c.frame[:size]
+	if _, err := io.ReadFull(c.r, frame); err != nil {
+		if err == io.EOF {
+			err = io.ErrUnexpectedEOF
+		}
+		c.err = err
+		return
+	}
+
+	out, err := c.enc.AppendDecode(c.buf[:0], frame)
+	if err == nil && len(out) > StreamChunkSize {
+		err = ErrInvalidFormat()
+	}
+	if err != nil {
+		c.err = err
+		return
+	}
+
+	c.out = out
+}
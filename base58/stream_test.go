@@ -0,0 +1,281 @@
+// Copyright © 2020-2021 The EVEN Solutions Developers Team
+
+package base58_test
+
+import (
+	stdbytes "bytes"
+	"io"
+	"io/ioutil"
+	"reflect"
+	"strings"
+	"testing"
+
+	. "github.com/platsko/go-kit/base58"
+	"github.com/platsko/go-kit/bytes"
+	"github.com/platsko/go-kit/errors"
+)
+
+type (
+	// failWriter fails all writes with the error.
+	failWriter struct {
+		err error
+	}
+)
+
+func (c failWriter) Write([]byte) (int, error) {
+	return 0, c.err
+}
+
+func mockStreamEncode(enc *Encoding, blob []byte) []byte {
+	buf := stdbytes.Buffer{}
+	w := NewEncoder(enc, &buf)
+	if _, err := w.Write(blob); err != nil {
+		panic(err)
+	}
+	if err := w.Close(); err != nil {
+		panic(err)
+	}
+
+	return buf.Bytes()
+}
+
+// mockStreamFrame prefixes the encoded chunk with its length
+// written as two digits of the Bitcoin alphabet.
+func mockStreamFrame(encoded string) string {
+	size := len(encoded)
+
+	return string([]byte{BTCAlphabet[size/58], BTCAlphabet[size%58]}) + encoded
+}
+
+// mockStreamFrames counts frames of the stream encoded with the alphabet.
+func mockStreamFrames(alphabet string, stream []byte) int {
+	frames := 0
+	for len(stream) >= 2 {
+		size := strings.IndexByte(alphabet, stream[0])*58 + strings.IndexByte(alphabet, stream[1])
+		if size <= 0 || 2+size > len(stream) {
+			return -1
+		}
+		stream = stream[2+size:]
+		frames++
+	}
+	if len(stream) > 0 {
+		return -1
+	}
+
+	return frames
+}
+
+func Benchmark_NewEncoder(b *testing.B) {
+	blob := bytes.RandBytes(64 * 1024)
+	b.SetBytes(int64(len(blob)))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		w := NewEncoder(BTCEncoding, ioutil.Discard)
+		if _, err := w.Write(blob); err != nil {
+			b.Fatal(err)
+		}
+		if err := w.Close(); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func Benchmark_NewDecoder(b *testing.B) {
+	stream := mockStreamEncode(BTCEncoding, bytes.RandBytes(64*1024))
+	b.SetBytes(int64(len(stream)))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		r := NewDecoder(BTCEncoding, stdbytes.NewReader(stream))
+		if _, err := io.Copy(ioutil.Discard, r); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func Test_NewEncoder(t *testing.T) {
+	t.Parallel()
+
+	zeros := make([]byte, StreamChunkSize+1)
+	tests := [7]struct {
+		name     string
+		enc      *Encoding
+		alphabet string
+		blob     []byte
+	}{
+		{
+			name:     "empty_OK",
+			enc:      BTCEncoding,
+			alphabet: BTCAlphabet,
+		},
+		{
+			name:     "single_byte_OK",
+			enc:      BTCEncoding,
+			alphabet: BTCAlphabet,
+			blob:     []byte{1},
+		},
+		{
+			name:     "chunk_OK",
+			enc:      BTCEncoding,
+			alphabet: BTCAlphabet,
+			blob:     bytes.RandBytes(StreamChunkSize),
+		},
+		{
+			name:     "chunk_and_tail_OK",
+			enc:      FlickrEncoding,
+			alphabet: FlickrAlphabet,
+			blob:     bytes.RandBytes(StreamChunkSize + 1),
+		},
+		{
+			name:     "zeros_OK",
+			enc:      BTCEncoding,
+			alphabet: BTCAlphabet,
+			blob:     zeros,
+		},
+		{
+			name:     "long_OK",
+			enc:      RippleEncoding,
+			alphabet: RippleAlphabet,
+			blob:     bytes.RandBytes(10*StreamChunkSize + 17),
+		},
+		{
+			name:     "leading_zeros_OK",
+			enc:      BTCEncoding,
+			alphabet: BTCAlphabet,
+			blob:     append([]byte{0, 0, 0}, bytes.RandBytes(2*StreamChunkSize)...),
+		},
+	}
+
+	for idx := range tests {
+		test := tests[idx]
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			stream := mockStreamEncode(test.enc, test.blob)
+			want := (len(test.blob) + StreamChunkSize - 1) / StreamChunkSize
+			if frames := mockStreamFrames(test.alphabet, stream); frames != want {
+				t.Errorf("NewEncoder() got frames: %v | want: %v", frames, want)
+			}
+
+			got, err := ioutil.ReadAll(NewDecoder(test.enc, stdbytes.NewReader(stream)))
+			if err != nil {
+				t.Errorf("NewDecoder() error: %v | want: %v", err, nil)
+				return
+			}
+			if !stdbytes.Equal(got, test.blob) {
+				t.Errorf("NewDecoder() got: %#v | want: %#v", got, test.blob)
+			}
+		})
+	}
+}
+
+func Test_NewEncoder_Write(t *testing.T) {
+	t.Parallel()
+
+	blob := bytes.RandBytes(3*StreamChunkSize + 5)
+	buf := stdbytes.Buffer{}
+	w := NewEncoder(BTCEncoding, &buf)
+	for pos := 0; pos < len(blob); pos += 7 { // write in small pieces
+		end := pos + 7
+		if end > len(blob) {
+			end = len(blob)
+		}
+		if _, err := w.Write(blob[pos:end]); err != nil {
+			t.Fatalf("Write() error: %v | want: %v", err, nil)
+		}
+	}
+	if err := w.Close(); err != nil {
+		t.Fatalf("Close() error: %v | want: %v", err, nil)
+	}
+
+	if want := mockStreamEncode(BTCEncoding, blob); !reflect.DeepEqual(buf.Bytes(), want) {
+		t.Errorf("Write() got: %s | want: %s", buf.Bytes(), want)
+	}
+
+	wantErr := errors.New("write failed")
+	w = NewEncoder(BTCEncoding, failWriter{err: wantErr})
+	if _, err := w.Write(blob); !errors.Is(err, wantErr) {
+		t.Errorf("Write() error: %v | want: %v", err, wantErr)
+	}
+	if err := w.Close(); !errors.Is(err, wantErr) {
+		t.Errorf("Close() error: %v | want: %v", err, wantErr)
+	}
+}
+
+func Test_NewDecoder(t *testing.T) {
+	t.Parallel()
+
+	hello := mockStreamFrame("2NEpo7TZRRrLZSi2U")
+	oversized := mockStreamFrame(EncodeToString(bytes.RandBytes(StreamChunkSize + 1)))
+
+	tests := [9]struct {
+		name    string
+		stream  string
+		want    []byte
+		wantErr error
+	}{
+		{
+			name:   "frames_OK",
+			stream: hello + hello,
+			want:   []byte("Hello World!Hello World!"),
+		},
+		{
+			name:   "empty_OK",
+			stream: "",
+			want:   []byte{},
+		},
+		{
+			name:    "unknown_symbol_ERR",
+			stream:  hello + mockStreamFrame("0OIl"),
+			wantErr: ErrUnknownFormat(),
+		},
+		{
+			name:    "oversized_chunk_ERR",
+			stream:  oversized,
+			wantErr: ErrInvalidFormat(),
+		},
+		{
+			name:    "long_frame_ERR",
+			stream:  mockStreamFrame(strings.Repeat("1", 2*StreamChunkSize)),
+			wantErr: ErrInvalidFormat(),
+		},
+		{
+			name:    "zero_length_ERR",
+			stream:  "11" + hello,
+			wantErr: ErrInvalidFormat(),
+		},
+		{
+			name:    "invalid_prefix_ERR",
+			stream:  "\n" + hello,
+			wantErr: ErrInvalidFormat(),
+		},
+		{
+			name:    "truncated_frame_ERR",
+			stream:  hello + hello[:10],
+			wantErr: io.ErrUnexpectedEOF,
+		},
+		{
+			name:    "truncated_prefix_ERR",
+			stream:  hello + hello[:1],
+			wantErr: io.ErrUnexpectedEOF,
+		},
+	}
+
+	for idx := range tests {
+		test := tests[idx]
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := ioutil.ReadAll(NewDecoder(BTCEncoding, strings.NewReader(test.stream)))
+			if !errors.Is(err, test.wantErr) {
+				t.Errorf("NewDecoder() error: %v | want: %v", err, test.wantErr)
+				return
+			}
+			if test.wantErr != nil {
+				return
+			}
+			if !stdbytes.Equal(got, test.want) {
+				t.Errorf("NewDecoder() got: %#v | want: %#v", got, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,353 @@
+// Copyright © 2020-2021 The EVEN Solutions Developers Team
+
+package bech32
+
+import (
+	"strings"
+)
+
+const (
+	// Charset is the bech32 alphabet of 5-bit values.
+	Charset = "qpzry9x8gf2tvdw0s3jn54khce6mua7l"
+
+	// Separator separates the human-readable part from the data part.
+	Separator = '1'
+
+	// ChecksumSize is a size of checksum in 5-bit values.
+	ChecksumSize = 6
+
+	// MaxLength is a maximum length of the bech32 string.
+	MaxLength = 90
+
+	// bech32Const is the checksum constant of Bech32 variant.
+	bech32Const = 1
+
+	// bech32mConst is the checksum constant of Bech32m variant.
+	bech32mConst = 0x2bc830a3
+
+	// i255 is a magic number represented symbol that does not exist in Charset.
+	i255 = 255
+)
+
+const (
+	// Invalid is unknown checksum variant.
+	Invalid Variant = iota
+
+	// Bech32 is the checksum variant defined by BIP-173.
+	Bech32
+
+	// Bech32m is the checksum variant defined by BIP-350.
+	Bech32m
+)
+
+type (
+	// Variant represents bech32 checksum variant.
+	Variant int
+)
+
+var (
+	// charsetRev maps lower case symbols to its indexes in Charset.
+	charsetRev = func() (table [128]byte) {
+		for i := range table {
+			table[i] = i255
+		}
+		for i := 0; i < len(Charset); i++ {
+			table[Charset[i]] = byte(i)
+		}
+
+		return table
+	}()
+
+	// generator is the BCH code generator coefficients.
+	generator = [5]uint32{0x3b6a57b2, 0x26508e6d, 0x1ea119fa, 0x3d4233dd, 0x2a1462b3}
+)
+
+// Encode encodes the human-readable part and 5-bit data values
+// to the string with the checksum of the variant.
+// The result is lower case, the human-readable part is lowered as well.
+func Encode(hrp string, data []byte, variant Variant) (string, error) {
+	cons, ok := variant.constant()
+	if !ok {
+		return "", ErrInvalidVariant()
+	}
+
+	if err := validateHRP(hrp); err != nil {
+		return "", err
+	}
+
+	hrp = strings.ToLower(hrp)
+	if len(hrp)+1+len(data)+ChecksumSize > MaxLength {
+		return "", ErrInvalidLength()
+	}
+
+	for idx, b := range data {
+		if b >= 32 { // nolint: gomnd
+			return "", &PositionError{Pos: len(hrp) + 1 + idx, Err: ErrInvalidDataRange()}
+		}
+	}
+
+	sb := strings.Builder{}
+	sb.Grow(len(hrp) + 1 + len(data) + ChecksumSize)
+	sb.WriteString(hrp)
+	sb.WriteByte(Separator)
+	for _, b := range data {
+		sb.WriteByte(Charset[b])
+	}
+	for _, b := range createChecksum(hrp, data, cons) {
+		sb.WriteByte(Charset[b])
+	}
+
+	return sb.String(), nil
+}
+
+// EncodeBytes converts 8-bit data to 5-bit values and encodes it.
+func EncodeBytes(hrp string, data []byte, variant Variant) (string, error) {
+	values, err := ConvertBits(data, 8, 5, true) // nolint: gomnd
+	if err != nil {
+		return "", err
+	}
+
+	return Encode(hrp, values, variant)
+}
+
+// Decode decodes the string to the lower case human-readable part
+// and 5-bit data values without the checksum and detects the checksum variant.
+// Position of the invalid symbol is reported by PositionError,
+// it also reports position of the single substituted symbol
+// when the checksum is invalid and the error can be located.
+func Decode(s string) (string, []byte, Variant, error) {
+	if len(s) > MaxLength {
+		return "", nil, Invalid, ErrInvalidLength()
+	}
+
+	lower, upper := false, false
+	for idx := 0; idx < len(s); idx++ {
+		b := s[idx]
+		switch {
+		case b < 33 || b > 126: // nolint: gomnd
+			return "", nil, Invalid, &PositionError{Pos: idx, Err: ErrInvalidCharacter()}
+
+		case b >= 'a' && b <= 'z':
+			lower = true
+
+		case b >= 'A' && b <= 'Z':
+			upper = true
+		}
+
+		if lower && upper {
+			return "", nil, Invalid, &PositionError{Pos: idx, Err: ErrMixedCase()}
+		}
+	}
+
+	s = strings.ToLower(s)
+	sep := strings.LastIndexByte(s, Separator)
+	if sep < 0 {
+		return "", nil, Invalid, ErrInvalidSeparator()
+	}
+	if sep == 0 {
+		return "", nil, Invalid, ErrInvalidHRP()
+	}
+	if len(s)-sep-1 < ChecksumSize {
+		return "", nil, Invalid, &PositionError{Pos: sep, Err: ErrInvalidSeparator()}
+	}
+
+	hrp, values := s[:sep], make([]byte, len(s)-sep-1)
+	for idx := range values {
+		pos := sep + 1 + idx
+		val := charsetRev[s[pos]]
+		if val == i255 {
+			return "", nil, Invalid, &PositionError{Pos: pos, Err: ErrInvalidCharacter()}
+		}
+		values[idx] = val
+	}
+
+	variant := checksumVariant(polymod(hrp, values))
+	if variant == Invalid {
+		err := error(ErrInvalidChecksum())
+		if pos := locateError(hrp, values); pos >= 0 {
+			err = &PositionError{Pos: sep + 1 + pos, Err: err}
+		}
+		return "", nil, Invalid, err
+	}
+
+	return hrp, values[:len(values)-ChecksumSize], variant, nil
+}
+
+// DecodeBytes decodes the string and converts 5-bit data values to 8-bit data.
+func DecodeBytes(s string) (string, []byte, Variant, error) {
+	hrp, values, variant, err := Decode(s)
+	if err != nil {
+		return "", nil, Invalid, err
+	}
+
+	data, err := ConvertBits(values, 5, 8, false) // nolint: gomnd
+	if err != nil {
+		return "", nil, Invalid, err
+	}
+
+	return hrp, data, variant, nil
+}
+
+// ConvertBits regroups the data values of fromBits size to toBits size values.
+// When pad is true the last incomplete group is padded with zero bits,
+// otherwise it has to be shorter than fromBits and zero filled.
+func ConvertBits(data []byte, fromBits, toBits uint, pad bool) ([]byte, error) {
+	if fromBits < 1 || fromBits > 8 || toBits < 1 || toBits > 8 {
+		return nil, ErrInvalidBitGroups()
+	}
+
+	acc, bits, maxVal := uint32(0), uint(0), uint32(1)<<toBits-1
+	result := make([]byte, 0, (len(data)*int(fromBits)+int(toBits)-1)/int(toBits))
+	for idx, b := range data {
+		if uint32(b)>>fromBits != 0 {
+			return nil, &PositionError{Pos: idx, Err: ErrInvalidDataRange()}
+		}
+
+		acc = acc<<fromBits | uint32(b)
+		bits += fromBits
+		for bits >= toBits {
+			bits -= toBits
+			result = append(result, byte(acc>>bits&maxVal))
+		}
+	}
+
+	switch {
+	case pad && bits > 0:
+		result = append(result, byte(acc<<(toBits-bits)&maxVal))
+
+	case !pad && (bits >= fromBits || acc<<(toBits-bits)&maxVal != 0):
+		return nil, ErrInvalidPadding()
+	}
+
+	return result, nil
+}
+
+// String implements stringer interface.
+func (c Variant) String() string {
+	switch c {
+	case Bech32:
+		return "bech32"
+
+	case Bech32m:
+		return "bech32m"
+	}
+
+	return "invalid"
+}
+
+// constant returns the checksum constant of the variant.
+func (c Variant) constant() (uint32, bool) {
+	switch c {
+	case Bech32:
+		return bech32Const, true
+
+	case Bech32m:
+		return bech32mConst, true
+	}
+
+	return 0, false
+}
+
+// checksumVariant returns the variant matching the polymod residue.
+func checksumVariant(residue uint32) Variant {
+	switch residue {
+	case bech32Const:
+		return Bech32
+
+	case bech32mConst:
+		return Bech32m
+	}
+
+	return Invalid
+}
+
+// createChecksum calculates checksum values of the data.
+func createChecksum(hrp string, data []byte, cons uint32) []byte {
+	values := make([]byte, len(data)+ChecksumSize)
+	copy(values, data)
+
+	mod := polymod(hrp, values) ^ cons
+	checksum := make([]byte, ChecksumSize)
+	for i := range checksum {
+		checksum[i] = byte(mod >> (5 * (5 - i)) & 31) // nolint: gomnd
+	}
+
+	return checksum
+}
+
+// locateError returns position of the single symbol substitution
+// which makes the checksum valid or -1 if there is no such position.
+func locateError(hrp string, values []byte) int {
+	for pos := range values {
+		orig := values[pos]
+		for val := byte(0); val < 32; val++ { // nolint: gomnd
+			if val == orig {
+				continue
+			}
+
+			values[pos] = val
+			if checksumVariant(polymod(hrp, values)) != Invalid {
+				values[pos] = orig
+				return pos
+			}
+		}
+		values[pos] = orig
+	}
+
+	return -1
+}
+
+// polymod calculates BCH checksum over expanded hrp and the values.
+func polymod(hrp string, values []byte) uint32 {
+	chk := uint32(1)
+	step := func(v byte) {
+		top := chk >> 25 // nolint: gomnd
+		chk = (chk&0x1ffffff)<<5 ^ uint32(v)
+		for i, g := range generator {
+			if (top>>uint(i))&1 == 1 {
+				chk ^= g
+			}
+		}
+	}
+
+	for i := 0; i < len(hrp); i++ {
+		step(hrp[i] >> 5) // nolint: gomnd
+	}
+	step(0)
+	for i := 0; i < len(hrp); i++ {
+		step(hrp[i] & 31) // nolint: gomnd
+	}
+	for _, v := range values {
+		step(v)
+	}
+
+	return chk
+}
+
+// validateHRP checks the human-readable part symbols and length.
+func validateHRP(hrp string) error {
+	if len(hrp) < 1 || len(hrp) > MaxLength-1-ChecksumSize {
+		return ErrInvalidHRP()
+	}
+
+	lower, upper := false, false
+	for idx := 0; idx < len(hrp); idx++ {
+		b := hrp[idx]
+		switch {
+		case b < 33 || b > 126: // nolint: gomnd
+			return &PositionError{Pos: idx, Err: ErrInvalidHRP()}
+
+		case b >= 'a' && b <= 'z':
+			lower = true
+
+		case b >= 'A' && b <= 'Z':
+			upper = true
+		}
+
+		if lower && upper {
+			return &PositionError{Pos: idx, Err: ErrMixedCase()}
+		}
+	}
+
+	return nil
+}
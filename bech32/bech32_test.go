@@ -0,0 +1,398 @@
+// Copyright © 2020-2021 The EVEN Solutions Developers Team
+
+package bech32_test
+
+import (
+	"encoding/hex"
+	"reflect"
+	"strings"
+	"testing"
+
+	. "github.com/platsko/go-kit/bech32"
+	"github.com/platsko/go-kit/bytes"
+	"github.com/platsko/go-kit/errors"
+)
+
+func Benchmark_EncodeBytes(b *testing.B) {
+	blob := bytes.RandBytes(32)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := EncodeBytes("kit", blob, Bech32m); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func Benchmark_DecodeBytes(b *testing.B) {
+	str, err := EncodeBytes("kit", bytes.RandBytes(32), Bech32m)
+	if err != nil {
+		b.Fatal(err)
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, _, _, err = DecodeBytes(str); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func Test_Decode_Valid(t *testing.T) {
+	t.Parallel()
+
+	type (
+		testCase struct {
+			name    string
+			str     string
+			variant Variant
+		}
+		testList []testCase
+	)
+
+	tests := make(testList, 0, len(mockValidBech32)+len(mockValidBech32m))
+	for _, str := range mockValidBech32 {
+		tests = append(tests, testCase{name: str + "_OK", str: str, variant: Bech32})
+	}
+	for _, str := range mockValidBech32m {
+		tests = append(tests, testCase{name: str + "_OK", str: str, variant: Bech32m})
+	}
+
+	for idx := range tests {
+		test := tests[idx]
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			hrp, data, variant, err := Decode(test.str)
+			if err != nil {
+				t.Errorf("Decode() error: %v | want: %v", err, nil)
+				return
+			}
+			if variant != test.variant {
+				t.Errorf("Decode() variant: %v | want: %v", variant, test.variant)
+			}
+
+			got, err := Encode(hrp, data, variant)
+			if err != nil {
+				t.Errorf("Encode() error: %v | want: %v", err, nil)
+				return
+			}
+			if want := strings.ToLower(test.str); got != want {
+				t.Errorf("Encode() got: %v | want: %v", got, want)
+			}
+		})
+	}
+}
+
+func Test_Decode_Invalid(t *testing.T) {
+	t.Parallel()
+
+	tests := [14]struct {
+		name    string
+		str     string
+		wantErr error
+		wantPos int
+	}{
+		{
+			name:    "HRP_space_ERR",
+			str:     "\x201nwldj5",
+			wantErr: ErrInvalidCharacter(),
+			wantPos: 0,
+		},
+		{
+			name:    "HRP_DEL_ERR",
+			str:     "\x7f1axkwrx",
+			wantErr: ErrInvalidCharacter(),
+			wantPos: 0,
+		},
+		{
+			name:    "HRP_non_ASCII_ERR",
+			str:     "\x801eym55h",
+			wantErr: ErrInvalidCharacter(),
+			wantPos: 0,
+		},
+		{
+			name:    "max_length_ERR",
+			str:     "an84characterslonghumanreadablepartthatcontainsthenumber1andtheexcludedcharactersbio1569pvx",
+			wantErr: ErrInvalidLength(),
+			wantPos: -1,
+		},
+		{
+			name:    "no_separator_ERR",
+			str:     "pzry9x0s0muk",
+			wantErr: ErrInvalidSeparator(),
+			wantPos: -1,
+		},
+		{
+			name:    "empty_HRP_ERR",
+			str:     "1pzry9x0s0muk",
+			wantErr: ErrInvalidHRP(),
+			wantPos: -1,
+		},
+		{
+			name:    "data_character_ERR",
+			str:     "x1b4n0q5v",
+			wantErr: ErrInvalidCharacter(),
+			wantPos: 2,
+		},
+		{
+			name:    "short_checksum_ERR",
+			str:     "li1dgmt3",
+			wantErr: ErrInvalidSeparator(),
+			wantPos: 2,
+		},
+		{
+			name:    "checksum_character_ERR",
+			str:     "de1lg7wt\xff",
+			wantErr: ErrInvalidCharacter(),
+			wantPos: 8,
+		},
+		{
+			name:    "upper_case_checksum_ERR",
+			str:     "A1G7SGD8",
+			wantErr: ErrInvalidChecksum(),
+			wantPos: -1,
+		},
+		{
+			name:    "empty_HRP_short_ERR",
+			str:     "10a06t8",
+			wantErr: ErrInvalidHRP(),
+			wantPos: -1,
+		},
+		{
+			name:    "mixed_case_ERR",
+			str:     "a12UEL5L",
+			wantErr: ErrMixedCase(),
+			wantPos: 3,
+		},
+		{
+			name:    "substituted_symbol_ERR",
+			str:     "abcdef1qpzry9x8gf2tvdw0s3jn54khce6mua7lmqqqxx",
+			wantErr: ErrInvalidChecksum(),
+			wantPos: 44,
+		},
+		{
+			name:    "substituted_data_symbol_ERR",
+			str:     "abcdef1qpzrz9x8gf2tvdw0s3jn54khce6mua7lmqqqxw",
+			wantErr: ErrInvalidChecksum(),
+			wantPos: 11,
+		},
+	}
+
+	for idx := range tests {
+		test := tests[idx]
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, _, _, err := Decode(test.str)
+			if !errors.Is(err, test.wantErr) {
+				t.Errorf("Decode() error: %v | want: %v", err, test.wantErr)
+				return
+			}
+
+			pos := -1
+			if posErr, ok := err.(*PositionError); ok {
+				pos = posErr.Pos
+			}
+			if pos != test.wantPos {
+				t.Errorf("Decode() error position: %v | want: %v", pos, test.wantPos)
+			}
+		})
+	}
+}
+
+func Test_EncodeBytes(t *testing.T) {
+	t.Parallel()
+
+	tests := [6]struct {
+		name    string
+		hrp     string
+		data    string
+		variant Variant
+		want    string
+		wantErr error
+	}{
+		{
+			name:    "P2WPKH_program_OK",
+			hrp:     "bc",
+			data:    "751e76e8199196d454941c45d1b3a323f1433bd6",
+			variant: Bech32,
+			want:    "bc1w508d6qejxtdg4y5r3zarvary0c5xw7kj7gz7z",
+		},
+		{
+			name:    "upper_case_HRP_OK",
+			hrp:     "KIT",
+			data:    "00",
+			variant: Bech32m,
+			want:    "kit1qqx7vpd8",
+		},
+		{
+			name:    "empty_data_OK",
+			hrp:     "a",
+			variant: Bech32m,
+			want:    "a1lqfn3a",
+		},
+		{
+			name:    "invalid_variant_ERR",
+			hrp:     "kit",
+			variant: Invalid,
+			wantErr: ErrInvalidVariant(),
+		},
+		{
+			name:    "empty_HRP_ERR",
+			variant: Bech32,
+			wantErr: ErrInvalidHRP(),
+		},
+		{
+			name:    "max_length_ERR",
+			hrp:     "kit",
+			data:    hex.EncodeToString(make([]byte, 51)),
+			variant: Bech32m,
+			wantErr: ErrInvalidLength(),
+		},
+	}
+
+	for idx := range tests {
+		test := tests[idx]
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			data, _ := hex.DecodeString(test.data)
+			got, err := EncodeBytes(test.hrp, data, test.variant)
+			if !errors.Is(err, test.wantErr) {
+				t.Errorf("EncodeBytes() error: %v | want: %v", err, test.wantErr)
+				return
+			}
+			if test.wantErr != nil {
+				return
+			}
+			if got != test.want {
+				t.Errorf("EncodeBytes() got: %v | want: %v", got, test.want)
+			}
+
+			hrp, blob, variant, err := DecodeBytes(got)
+			if err != nil {
+				t.Errorf("DecodeBytes() error: %v | want: %v", err, nil)
+				return
+			}
+			if hrp != strings.ToLower(test.hrp) || variant != test.variant || hex.EncodeToString(blob) != test.data {
+				t.Errorf("DecodeBytes() got: %v %x %v | want: %v %v %v", hrp, blob, variant, test.hrp, test.data, test.variant)
+			}
+		})
+	}
+}
+
+func Test_Encode_DataRange(t *testing.T) {
+	t.Parallel()
+
+	_, err := Encode("kit", []byte{0, 31, 32}, Bech32)
+	if !errors.Is(err, ErrInvalidDataRange()) {
+		t.Fatalf("Encode() error: %v | want: %v", err, ErrInvalidDataRange())
+	}
+	if posErr, ok := err.(*PositionError); !ok || posErr.Pos != 6 {
+		t.Errorf("Encode() error position: %#v | want: %v", err, 6)
+	}
+}
+
+func Test_ConvertBits(t *testing.T) {
+	t.Parallel()
+
+	tests := [7]struct {
+		name     string
+		data     []byte
+		fromBits uint
+		toBits   uint
+		pad      bool
+		want     []byte
+		wantErr  error
+	}{
+		{
+			name:     "8_to_5_pad_OK",
+			data:     []byte{0xff},
+			fromBits: 8,
+			toBits:   5,
+			pad:      true,
+			want:     []byte{31, 28},
+		},
+		{
+			name:     "5_to_8_OK",
+			data:     []byte{31, 28},
+			fromBits: 5,
+			toBits:   8,
+			want:     []byte{0xff},
+		},
+		{
+			name:     "empty_OK",
+			fromBits: 8,
+			toBits:   5,
+			want:     []byte{},
+		},
+		{
+			name:     "non_zero_padding_ERR",
+			data:     []byte{31, 29},
+			fromBits: 5,
+			toBits:   8,
+			wantErr:  ErrInvalidPadding(),
+		},
+		{
+			name:     "excess_padding_ERR",
+			data:     []byte{31, 28, 0},
+			fromBits: 5,
+			toBits:   8,
+			wantErr:  ErrInvalidPadding(),
+		},
+		{
+			name:     "data_range_ERR",
+			data:     []byte{1, 32},
+			fromBits: 5,
+			toBits:   8,
+			wantErr:  ErrInvalidDataRange(),
+		},
+		{
+			name:     "bit_groups_ERR",
+			data:     []byte{1},
+			fromBits: 9,
+			toBits:   5,
+			wantErr:  ErrInvalidBitGroups(),
+		},
+	}
+
+	for idx := range tests {
+		test := tests[idx]
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := ConvertBits(test.data, test.fromBits, test.toBits, test.pad)
+			if !errors.Is(err, test.wantErr) {
+				t.Errorf("ConvertBits() error: %v | want: %v", err, test.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, test.want) && test.wantErr == nil {
+				t.Errorf("ConvertBits() got: %v | want: %v", got, test.want)
+			}
+		})
+	}
+}
+
+func Test_Variant_String(t *testing.T) {
+	t.Parallel()
+
+	tests := [3]struct {
+		name    string
+		variant Variant
+		want    string
+	}{
+		{name: "Bech32_OK", variant: Bech32, want: "bech32"},
+		{name: "Bech32m_OK", variant: Bech32m, want: "bech32m"},
+		{name: "Invalid_OK", variant: Invalid, want: "invalid"},
+	}
+
+	for idx := range tests {
+		test := tests[idx]
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := test.variant.String(); got != test.want {
+				t.Errorf("String() got: %v | want: %v", got, test.want)
+			}
+		})
+	}
+}
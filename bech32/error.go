@@ -0,0 +1,94 @@
+// Copyright © 2020-2021 The EVEN Solutions Developers Team
+
+package bech32
+
+import (
+	"strconv"
+
+	"github.com/platsko/go-kit/errors"
+)
+
+const (
+	ErrInvalidBitGroupsMsg = "invalid bit groups"
+	ErrInvalidCharacterMsg = "invalid character"
+	ErrInvalidChecksumMsg  = "invalid checksum"
+	ErrInvalidDataRangeMsg = "invalid data range"
+	ErrInvalidHRPMsg       = "invalid human-readable part"
+	ErrInvalidLengthMsg    = "invalid length"
+	ErrInvalidPaddingMsg   = "invalid padding"
+	ErrInvalidSeparatorMsg = "invalid separator"
+	ErrInvalidVariantMsg   = "invalid variant"
+	ErrMixedCaseMsg        = "mixed case"
+)
+
+var (
+	errInvalidBitGroups = errors.New(ErrInvalidBitGroupsMsg)
+	errInvalidCharacter = errors.New(ErrInvalidCharacterMsg)
+	errInvalidChecksum  = errors.New(ErrInvalidChecksumMsg)
+	errInvalidDataRange = errors.New(ErrInvalidDataRangeMsg)
+	errInvalidHRP       = errors.New(ErrInvalidHRPMsg)
+	errInvalidLength    = errors.New(ErrInvalidLengthMsg)
+	errInvalidPadding   = errors.New(ErrInvalidPaddingMsg)
+	errInvalidSeparator = errors.New(ErrInvalidSeparatorMsg)
+	errInvalidVariant   = errors.New(ErrInvalidVariantMsg)
+	errMixedCase        = errors.New(ErrMixedCaseMsg)
+)
+
+type (
+	// PositionError reports the error at the position of the input,
+	// it implements errors.Wrapper interface.
+	PositionError struct {
+		Pos int
+		Err error
+	}
+)
+
+// Error implements error Wrapper interface.
+func (e *PositionError) Error() string {
+	return e.Err.Error() + " at position " + strconv.Itoa(e.Pos)
+}
+
+// Unwrap implements error Wrapper interface.
+func (e *PositionError) Unwrap() error {
+	return e.Err
+}
+
+func ErrInvalidBitGroups() error {
+	return errInvalidBitGroups
+}
+
+func ErrInvalidCharacter() error {
+	return errInvalidCharacter
+}
+
+func ErrInvalidChecksum() error {
+	return errInvalidChecksum
+}
+
+func ErrInvalidDataRange() error {
+	return errInvalidDataRange
+}
+
+func ErrInvalidHRP() error {
+	return errInvalidHRP
+}
+
+func ErrInvalidLength() error {
+	return errInvalidLength
+}
+
+func ErrInvalidPadding() error {
+	return errInvalidPadding
+}
+
+func ErrInvalidSeparator() error {
+	return errInvalidSeparator
+}
+
+func ErrInvalidVariant() error {
+	return errInvalidVariant
+}
+
+func ErrMixedCase() error {
+	return errMixedCase
+}
@@ -0,0 +1,43 @@
+// Copyright © 2020-2021 The EVEN Solutions Developers Team
+
+package canonical
+
+import (
+	"github.com/platsko/go-kit/errors"
+)
+
+const (
+	ErrDuplicateKeyMsg     = "duplicate object key"
+	ErrInvalidJSONMsg      = "invalid json"
+	ErrInvalidNumberMsg    = "invalid number"
+	ErrInvalidStringMsg    = "invalid string"
+	ErrMaxDepthExceededMsg = "max depth exceeded"
+)
+
+var (
+	errDuplicateKey     = errors.New(ErrDuplicateKeyMsg)
+	errInvalidJSON      = errors.New(ErrInvalidJSONMsg)
+	errInvalidNumber    = errors.New(ErrInvalidNumberMsg)
+	errInvalidString    = errors.New(ErrInvalidStringMsg)
+	errMaxDepthExceeded = errors.New(ErrMaxDepthExceededMsg)
+)
+
+func ErrDuplicateKey() error {
+	return errDuplicateKey
+}
+
+func ErrInvalidJSON() error {
+	return errInvalidJSON
+}
+
+func ErrInvalidNumber() error {
+	return errInvalidNumber
+}
+
+func ErrInvalidString() error {
+	return errInvalidString
+}
+
+func ErrMaxDepthExceeded() error {
+	return errMaxDepthExceeded
+}
@@ -0,0 +1,508 @@
+// Copyright © 2020-2021 The EVEN Solutions Developers Team
+
+package canonical
+
+import (
+	"encoding/json"
+	"math"
+	"math/big"
+	"sort"
+	"strconv"
+	"unicode/utf16"
+	"unicode/utf8"
+)
+
+const (
+	// MaxDepth is the maximum nesting depth of JSON arrays and objects.
+	MaxDepth = 512
+
+	// MaxSafeInteger is the maximum integer exactly represented by IEEE 754 double,
+	// larger integers must be string-encoded as RFC 8785 and I-JSON require.
+	MaxSafeInteger = 1<<53 - 1
+
+	// hexDigits is used to escape control characters.
+	hexDigits = "0123456789abcdef"
+)
+
+type (
+	// member represents canonical JSON object member.
+	member struct {
+		key  string
+		sort []uint16
+		val  []byte
+	}
+
+	// parser transforms JSON text to canonical form.
+	parser struct {
+		data  []byte
+		pos   int
+		depth int
+	}
+)
+
+// JSON marshals the value to JSON and transforms it
+// to the canonical form defined by RFC 8785.
+// The standard library marshaler is used since it rejects
+// non-finite numbers and invalid UTF-8 is replaced with U+FFFD.
+// Numbers are IEEE 754 doubles, so int64 and uint64 fields that may exceed
+// MaxSafeInteger must be string-encoded, e.g. with `json:",string"` tag.
+func JSON(v interface{}) ([]byte, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	return TransformJSON(data)
+}
+
+// TransformJSON transforms JSON text to the canonical form defined by RFC 8785:
+// whitespace is removed, object members are sorted by UTF-16 code units
+// of the keys, strings use minimal escaping and numbers are serialized
+// as ECMAScript does for IEEE 754 double values.
+// Duplicate keys, invalid UTF-8 and numbers out of double range are rejected,
+// as well as integers beyond MaxSafeInteger which are not exactly represented
+// by the double, since distinct integers would be rounded to the same value.
+func TransformJSON(data []byte) ([]byte, error) {
+	p := parser{data: data}
+	p.skipSpaces()
+
+	out, err := p.value(make([]byte, 0, len(data)))
+	if err != nil {
+		return nil, err
+	}
+
+	p.skipSpaces()
+	if p.pos != len(p.data) {
+		return nil, ErrInvalidJSON()
+	}
+
+	return out, nil
+}
+
+// appendNumber appends the number serialized as ECMAScript Number.prototype.toString.
+func appendNumber(out []byte, num float64) []byte {
+	if num == 0 {
+		return append(out, '0') // negative zero as well
+	}
+	if num < 0 {
+		out = append(out, '-')
+		num = -num
+	}
+
+	// shortest round-trip digits and decimal exponent
+	sci := strconv.AppendFloat(nil, num, 'e', -1, 64)
+	exp := 0
+	digits := make([]byte, 0, len(sci))
+	for i, b := range sci {
+		if b == 'e' {
+			exp, _ = strconv.Atoi(string(sci[i+1:]))
+			break
+		}
+		if b != '.' {
+			digits = append(digits, b)
+		}
+	}
+
+	size, point := len(digits), exp+1
+	switch {
+	case size <= point && point <= 21:
+		out = append(out, digits...)
+		for i := size; i < point; i++ {
+			out = append(out, '0')
+		}
+
+	case 0 < point && point <= 21:
+		out = append(out, digits[:point]...)
+		out = append(out, '.')
+		out = append(out, digits[point:]...)
+
+	case -6 < point && point <= 0:
+		out = append(out, '0', '.')
+		for i := point; i < 0; i++ {
+			out = append(out, '0')
+		}
+		out = append(out, digits...)
+
+	default:
+		out = append(out, digits[0])
+		if size > 1 {
+			out = append(out, '.')
+			out = append(out, digits[1:]...)
+		}
+		out = append(out, 'e')
+		if exp > 0 {
+			out = append(out, '+')
+		}
+		out = strconv.AppendInt(out, int64(exp), 10) // nolint: gomnd
+	}
+
+	return out
+}
+
+// appendString appends the string quoted with minimal escaping.
+func appendString(out []byte, s string) []byte {
+	out = append(out, '"')
+	for i := 0; i < len(s); i++ {
+		b := s[i]
+		switch b {
+		case '"', '\\':
+			out = append(out, '\\', b)
+		case '\b':
+			out = append(out, '\\', 'b')
+		case '\f':
+			out = append(out, '\\', 'f')
+		case '\n':
+			out = append(out, '\\', 'n')
+		case '\r':
+			out = append(out, '\\', 'r')
+		case '\t':
+			out = append(out, '\\', 't')
+		default:
+			if b < ' ' {
+				out = append(out, '\\', 'u', '0', '0', hexDigits[b>>4], hexDigits[b&0xf]) // nolint: gomnd
+				continue
+			}
+			out = append(out, b)
+		}
+	}
+
+	return append(out, '"')
+}
+
+// array parses JSON array and appends it in canonical form.
+func (p *parser) array(out []byte) ([]byte, error) {
+	p.pos++ // skip '['
+	out = append(out, '[')
+
+	p.skipSpaces()
+	if p.consume(']') {
+		return append(out, ']'), nil
+	}
+
+	for {
+		var err error
+		if out, err = p.value(out); err != nil {
+			return nil, err
+		}
+
+		p.skipSpaces()
+		switch {
+		case p.consume(','):
+			out = append(out, ',')
+			p.skipSpaces()
+
+		case p.consume(']'):
+			return append(out, ']'), nil
+
+		default:
+			return nil, ErrInvalidJSON()
+		}
+	}
+}
+
+// consume skips the next byte if it matches.
+func (p *parser) consume(b byte) bool {
+	if p.pos < len(p.data) && p.data[p.pos] == b {
+		p.pos++
+		return true
+	}
+
+	return false
+}
+
+// digits skips decimal digits and returns their count.
+func (p *parser) digits() int {
+	start := p.pos
+	for p.pos < len(p.data) && p.data[p.pos] >= '0' && p.data[p.pos] <= '9' {
+		p.pos++
+	}
+
+	return p.pos - start
+}
+
+// escape parses escape sequence of JSON string and appends the unescaped rune.
+func (p *parser) escape(buf []byte) ([]byte, error) {
+	p.pos++ // skip '\\'
+	if p.pos >= len(p.data) {
+		return nil, ErrInvalidJSON()
+	}
+
+	b := p.data[p.pos]
+	p.pos++
+	switch b {
+	case '"', '\\', '/':
+		return append(buf, b), nil
+	case 'b':
+		return append(buf, '\b'), nil
+	case 'f':
+		return append(buf, '\f'), nil
+	case 'n':
+		return append(buf, '\n'), nil
+	case 'r':
+		return append(buf, '\r'), nil
+	case 't':
+		return append(buf, '\t'), nil
+	case 'u':
+	default:
+		return nil, ErrInvalidJSON()
+	}
+
+	r, err := p.hex4()
+	if err != nil {
+		return nil, err
+	}
+
+	if utf16.IsSurrogate(r) {
+		if !p.consume('\\') || !p.consume('u') {
+			return nil, ErrInvalidString()
+		}
+		low, err := p.hex4()
+		if err != nil {
+			return nil, err
+		}
+		if r = utf16.DecodeRune(r, low); r == utf8.RuneError {
+			return nil, ErrInvalidString()
+		}
+	}
+
+	rb := [utf8.UTFMax]byte{}
+	size := utf8.EncodeRune(rb[:], r)
+
+	return append(buf, rb[:size]...), nil
+}
+
+// hex4 parses four hex digits of unicode escape sequence.
+func (p *parser) hex4() (rune, error) {
+	if p.pos+4 > len(p.data) {
+		return 0, ErrInvalidJSON()
+	}
+
+	val, err := strconv.ParseUint(string(p.data[p.pos:p.pos+4]), 16, 16) // nolint: gomnd
+	if err != nil {
+		return 0, ErrInvalidJSON()
+	}
+
+	p.pos += 4
+
+	return rune(val), nil
+}
+
+// literal parses JSON literal and appends it as is.
+func (p *parser) literal(out []byte, lit string) ([]byte, error) {
+	end := p.pos + len(lit)
+	if end > len(p.data) || string(p.data[p.pos:end]) != lit {
+		return nil, ErrInvalidJSON()
+	}
+
+	p.pos = end
+
+	return append(out, lit...), nil
+}
+
+// number parses JSON number and appends it in canonical form.
+func (p *parser) number(out []byte) ([]byte, error) {
+	start, integer := p.pos, true
+	p.consume('-')
+
+	switch {
+	case p.consume('0'):
+	case p.digits() == 0:
+		return nil, ErrInvalidJSON()
+	}
+	if p.consume('.') {
+		if p.digits() == 0 {
+			return nil, ErrInvalidJSON()
+		}
+		integer = false
+	}
+	if p.consume('e') || p.consume('E') {
+		if !p.consume('+') {
+			p.consume('-')
+		}
+		if p.digits() == 0 {
+			return nil, ErrInvalidJSON()
+		}
+		integer = false
+	}
+
+	// the syntax is checked above, so the range error is the only one,
+	// underflow rounds to zero as ECMAScript does, overflow is rejected
+	lit := p.data[start:p.pos]
+	num, _ := strconv.ParseFloat(string(lit), 64)
+	if math.IsInf(num, 0) {
+		return nil, ErrInvalidNumber()
+	}
+
+	// integers beyond the safe range are accepted only if the double is exact,
+	// otherwise distinct integers would be silently rounded to the same value
+	if integer && math.Abs(num) > MaxSafeInteger {
+		exact, _ := new(big.Int).SetString(string(lit), 10)
+		if rounded, _ := big.NewFloat(num).Int(nil); exact.Cmp(rounded) != 0 {
+			return nil, ErrInvalidNumber()
+		}
+	}
+
+	return appendNumber(out, num), nil
+}
+
+// object parses JSON object and appends it in canonical form.
+func (p *parser) object(out []byte) ([]byte, error) {
+	p.pos++ // skip '{'
+
+	p.skipSpaces()
+	if p.consume('}') {
+		return append(out, '{', '}'), nil
+	}
+
+	members := make([]member, 0)
+	keys := make(map[string]struct{})
+	for {
+		if p.pos >= len(p.data) || p.data[p.pos] != '"' {
+			return nil, ErrInvalidJSON()
+		}
+
+		key, err := p.str()
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := keys[key]; ok {
+			return nil, ErrDuplicateKey()
+		}
+		keys[key] = struct{}{}
+
+		p.skipSpaces()
+		if !p.consume(':') {
+			return nil, ErrInvalidJSON()
+		}
+		p.skipSpaces()
+
+		val, err := p.value(nil)
+		if err != nil {
+			return nil, err
+		}
+
+		members = append(members, member{key: key, sort: utf16.Encode([]rune(key)), val: val})
+
+		p.skipSpaces()
+		if p.consume('}') {
+			break
+		}
+		if !p.consume(',') {
+			return nil, ErrInvalidJSON()
+		}
+		p.skipSpaces()
+	}
+
+	sort.Slice(members, func(i, j int) bool {
+		a, b := members[i].sort, members[j].sort
+		for k := 0; k < len(a) && k < len(b); k++ {
+			if a[k] != b[k] {
+				return a[k] < b[k]
+			}
+		}
+
+		return len(a) < len(b)
+	})
+
+	out = append(out, '{')
+	for i, m := range members {
+		if i > 0 {
+			out = append(out, ',')
+		}
+		out = appendString(out, m.key)
+		out = append(out, ':')
+		out = append(out, m.val...)
+	}
+
+	return append(out, '}'), nil
+}
+
+// skipSpaces skips insignificant whitespace.
+func (p *parser) skipSpaces() {
+	for p.pos < len(p.data) {
+		switch p.data[p.pos] {
+		case ' ', '\t', '\n', '\r':
+			p.pos++
+		default:
+			return
+		}
+	}
+}
+
+// str parses JSON string and returns its unescaped value.
+func (p *parser) str() (string, error) {
+	p.pos++ // skip '"'
+
+	buf := make([]byte, 0)
+	for p.pos < len(p.data) {
+		b := p.data[p.pos]
+		switch {
+		case b == '"':
+			p.pos++
+			return string(buf), nil
+
+		case b == '\\':
+			var err error
+			if buf, err = p.escape(buf); err != nil {
+				return "", err
+			}
+
+		case b < ' ':
+			return "", ErrInvalidJSON()
+
+		case b < utf8.RuneSelf:
+			buf = append(buf, b)
+			p.pos++
+
+		default:
+			r, size := utf8.DecodeRune(p.data[p.pos:])
+			if r == utf8.RuneError && size == 1 {
+				return "", ErrInvalidString()
+			}
+			buf = append(buf, p.data[p.pos:p.pos+size]...)
+			p.pos += size
+		}
+	}
+
+	return "", ErrInvalidJSON()
+}
+
+// value parses JSON value and appends it in canonical form.
+func (p *parser) value(out []byte) ([]byte, error) {
+	if p.pos >= len(p.data) {
+		return nil, ErrInvalidJSON()
+	}
+
+	switch b := p.data[p.pos]; {
+	case b == '{' || b == '[':
+		if p.depth++; p.depth > MaxDepth {
+			return nil, ErrMaxDepthExceeded()
+		}
+		defer func() { p.depth-- }()
+		if b == '{' {
+			return p.object(out)
+		}
+		return p.array(out)
+
+	case b == '"':
+		s, err := p.str()
+		if err != nil {
+			return nil, err
+		}
+		return appendString(out, s), nil
+
+	case b == 't':
+		return p.literal(out, "true")
+
+	case b == 'f':
+		return p.literal(out, "false")
+
+	case b == 'n':
+		return p.literal(out, "null")
+
+	case b == '-' || b >= '0' && b <= '9':
+		return p.number(out)
+	}
+
+	return nil, ErrInvalidJSON()
+}
@@ -0,0 +1,251 @@
+// Copyright © 2020-2021 The EVEN Solutions Developers Team
+
+package canonical_test
+
+import (
+	"math"
+	"strconv"
+	"strings"
+	"testing"
+
+	. "github.com/platsko/go-kit/canonical"
+	"github.com/platsko/go-kit/errors"
+)
+
+func Benchmark_TransformJSON(b *testing.B) {
+	data := []byte(mockJSONInput)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := TransformJSON(data); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func Test_TransformJSON(t *testing.T) {
+	t.Parallel()
+
+	tests := [8]struct {
+		name string
+		data string
+		want string
+	}{
+		{
+			name: "RFC_8785_structure_OK",
+			data: mockJSONInput,
+			want: mockJSONOutput,
+		},
+		{
+			name: "RFC_8785_sorting_OK",
+			data: mockSortingInput,
+			want: mockSortingOutput,
+		},
+		{
+			name: "whitespace_OK",
+			data: " \t\r\n[ 1 , { } , [ ] , \"\" ]\n",
+			want: `[1,{},[],""]`,
+		},
+		{
+			name: "nested_sorting_OK",
+			data: `{"b":{"d":1,"c":2},"a":[{"z":true,"y":null}]}`,
+			want: `{"a":[{"y":null,"z":true}],"b":{"c":2,"d":1}}`,
+		},
+		{
+			name: "escapes_OK",
+			data: `"\u0000\u001f\b\f\n\r\t\/é😀<>&"`,
+			want: "\"\\u0000\\u001f\\b\\f\\n\\r\\t/é😀<>&\"",
+		},
+		{
+			name: "scalar_OK",
+			data: "-0",
+			want: "0",
+		},
+		{
+			name: "underflow_OK",
+			data: "1e-400",
+			want: "0",
+		},
+		{
+			name: "max_exact_integer_OK",
+			data: "[9007199254740992,-9007199254740992]",
+			want: "[9007199254740992,-9007199254740992]",
+		},
+	}
+
+	for idx := range tests {
+		test := tests[idx]
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := TransformJSON([]byte(test.data))
+			if err != nil {
+				t.Errorf("TransformJSON() error: %v | want: %v", err, nil)
+				return
+			}
+			if string(got) != test.want {
+				t.Errorf("TransformJSON() got: %s | want: %s", got, test.want)
+			}
+
+			again, err := TransformJSON(got)
+			if err != nil || string(again) != test.want {
+				t.Errorf("TransformJSON() idempotence got: %s %v | want: %s", again, err, test.want)
+			}
+		})
+	}
+}
+
+func Test_TransformJSON_Numbers(t *testing.T) {
+	t.Parallel()
+
+	for bits, want := range mockNumbers {
+		num := math.Float64frombits(bits)
+		got, err := TransformJSON([]byte(strconv.FormatFloat(num, 'g', -1, 64)))
+		if err != nil {
+			t.Errorf("TransformJSON() error: %v | want: %v", err, nil)
+			continue
+		}
+		if string(got) != want {
+			t.Errorf("TransformJSON() bits: %#016x got: %s | want: %s", bits, got, want)
+		}
+	}
+}
+
+func Test_TransformJSON_LargeIntegers(t *testing.T) {
+	t.Parallel()
+
+	tests := [3]struct {
+		name string
+		data string
+		want string
+	}{
+		{
+			name: "power_of_ten_OK",
+			data: "1000000000000000000000",
+			want: "1e+21",
+		},
+		{
+			name: "power_of_two_OK",
+			data: "-295147905179352825856",
+			want: "-295147905179352830000",
+		},
+		{
+			name: "two_to_64_OK",
+			data: "18446744073709551616",
+			want: "18446744073709552000",
+		},
+	}
+
+	for idx := range tests {
+		test := tests[idx]
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := TransformJSON([]byte(test.data))
+			if err != nil {
+				t.Errorf("TransformJSON() error: %v | want: %v", err, nil)
+				return
+			}
+			if string(got) != test.want {
+				t.Errorf("TransformJSON() got: %s | want: %s", got, test.want)
+			}
+		})
+	}
+}
+
+func Test_TransformJSON_ERR(t *testing.T) {
+	t.Parallel()
+
+	tests := [17]struct {
+		name    string
+		data    string
+		wantErr error
+	}{
+		{name: "empty_ERR", data: "", wantErr: ErrInvalidJSON()},
+		{name: "trailing_data_ERR", data: "{} {}", wantErr: ErrInvalidJSON()},
+		{name: "trailing_comma_ERR", data: "[1,]", wantErr: ErrInvalidJSON()},
+		{name: "unquoted_key_ERR", data: "{a:1}", wantErr: ErrInvalidJSON()},
+		{name: "unterminated_string_ERR", data: `"abc`, wantErr: ErrInvalidJSON()},
+		{name: "control_character_ERR", data: "\"a\tb\"", wantErr: ErrInvalidJSON()},
+		{name: "leading_zero_ERR", data: "01", wantErr: ErrInvalidJSON()},
+		{name: "bad_literal_ERR", data: "nul", wantErr: ErrInvalidJSON()},
+		{name: "duplicate_key_ERR", data: `{"a":1,"a":2}`, wantErr: ErrDuplicateKey()},
+		{name: "number_overflow_ERR", data: "1e400", wantErr: ErrInvalidNumber()},
+		{name: "unsafe_integer_ERR", data: "9007199254740993", wantErr: ErrInvalidNumber()},
+		{name: "unsafe_negative_integer_ERR", data: "[-18446744073709551615]", wantErr: ErrInvalidNumber()},
+		{name: "inexact_large_integer_ERR", data: "295147905179352830000", wantErr: ErrInvalidNumber()},
+		{name: "lone_surrogate_ERR", data: `"\ud800"`, wantErr: ErrInvalidString()},
+		{name: "reversed_surrogates_ERR", data: `"\ude00\ud83d"`, wantErr: ErrInvalidString()},
+		{name: "invalid_UTF8_ERR", data: "\"\xff\"", wantErr: ErrInvalidString()},
+		{name: "max_depth_ERR", data: strings.Repeat("[", MaxDepth+1), wantErr: ErrMaxDepthExceeded()},
+	}
+
+	for idx := range tests {
+		test := tests[idx]
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			if _, err := TransformJSON([]byte(test.data)); !errors.Is(err, test.wantErr) {
+				t.Errorf("TransformJSON() error: %v | want: %v", err, test.wantErr)
+			}
+		})
+	}
+}
+
+func Test_JSON(t *testing.T) {
+	t.Parallel()
+
+	type payload struct {
+		Name   string             `json:"name"`
+		Amount float64            `json:"amount"`
+		Tags   map[string]float64 `json:"tags"`
+	}
+
+	value := payload{Name: "kit", Amount: 1e21, Tags: map[string]float64{"z": 0.1, "a": 100}}
+	mapped := map[string]interface{}{
+		"tags":   map[string]interface{}{"a": 100.0, "z": 0.1},
+		"amount": 1e21,
+		"name":   "kit",
+	}
+
+	want := `{"amount":1e+21,"name":"kit","tags":{"a":100,"z":0.1}}`
+	for _, v := range [2]interface{}{value, mapped} {
+		got, err := JSON(v)
+		if err != nil {
+			t.Errorf("JSON() error: %v | want: %v", err, nil)
+			continue
+		}
+		if string(got) != want {
+			t.Errorf("JSON() got: %s | want: %s", got, want)
+		}
+	}
+
+	if _, err := JSON(math.NaN()); err == nil {
+		t.Errorf("JSON() error: %v | want: not nil", err)
+	}
+}
+
+func Test_JSON_LargeInteger(t *testing.T) {
+	t.Parallel()
+
+	type (
+		number struct {
+			ID uint64 `json:"id"`
+		}
+		str struct {
+			ID uint64 `json:"id,string"`
+		}
+	)
+
+	if _, err := JSON(number{ID: 1<<53 + 1}); !errors.Is(err, ErrInvalidNumber()) {
+		t.Errorf("JSON() error: %v | want: %v", err, ErrInvalidNumber())
+	}
+
+	got, err := JSON(str{ID: 1<<53 + 1})
+	if err != nil {
+		t.Errorf("JSON() error: %v | want: %v", err, nil)
+		return
+	}
+	if want := `{"id":"9007199254740993"}`; string(got) != want {
+		t.Errorf("JSON() got: %s | want: %s", got, want)
+	}
+}
@@ -0,0 +1,20 @@
+// Copyright © 2020-2021 The EVEN Solutions Developers Team
+
+package canonical
+
+import (
+	"google.golang.org/protobuf/proto"
+
+	"github.com/platsko/go-kit/errors"
+)
+
+// Proto marshals the message to protobuf wire format in deterministic mode,
+// so map fields are ordered by keys and equal messages produce equal bytes
+// within the same binary and protobuf runtime version.
+func Proto(msg proto.Message) ([]byte, error) {
+	if msg == nil {
+		return nil, errors.ErrNilPointerValue()
+	}
+
+	return proto.MarshalOptions{Deterministic: true}.Marshal(msg)
+}
@@ -0,0 +1,61 @@
+// Copyright © 2020-2021 The EVEN Solutions Developers Team
+
+package canonical_test
+
+import (
+	"reflect"
+	"strconv"
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	. "github.com/platsko/go-kit/canonical"
+	"github.com/platsko/go-kit/errors"
+)
+
+func Test_Proto(t *testing.T) {
+	t.Parallel()
+
+	fields := make(map[string]interface{}, 64)
+	for i := 0; i < 64; i++ {
+		fields["key"+strconv.Itoa(i)] = float64(i)
+	}
+
+	msg, err := structpb.NewStruct(fields)
+	if err != nil {
+		t.Fatalf("NewStruct() error: %v | want: %v", err, nil)
+	}
+
+	want, err := Proto(msg)
+	if err != nil {
+		t.Fatalf("Proto() error: %v | want: %v", err, nil)
+	}
+
+	for i := 0; i < 16; i++ {
+		clone, _ := structpb.NewStruct(fields)
+		got, err := Proto(clone)
+		if err != nil {
+			t.Fatalf("Proto() error: %v | want: %v", err, nil)
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Fatalf("Proto() got: %x | want: %x", got, want)
+		}
+	}
+
+	decoded := structpb.Struct{}
+	if err = proto.Unmarshal(want, &decoded); err != nil {
+		t.Fatalf("Unmarshal() error: %v | want: %v", err, nil)
+	}
+	if !proto.Equal(&decoded, msg) {
+		t.Errorf("Unmarshal() got: %v | want: %v", &decoded, msg)
+	}
+}
+
+func Test_Proto_ERR(t *testing.T) {
+	t.Parallel()
+
+	if _, err := Proto(nil); !errors.Is(err, errors.ErrNilPointerValue()) {
+		t.Errorf("Proto() error: %v | want: %v", err, errors.ErrNilPointerValue())
+	}
+}
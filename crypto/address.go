@@ -0,0 +1,242 @@
+// Copyright © 2020-2021 The EVEN Solutions Developers Team
+
+package crypto
+
+import (
+	"math"
+
+	json "github.com/json-iterator/go"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/platsko/go-kit/base58"
+	"github.com/platsko/go-kit/bech32"
+	"github.com/platsko/go-kit/crypto/proto/pb"
+	"github.com/platsko/go-kit/errors"
+)
+
+type (
+	// Address represents an account address derived from the public key,
+	// it consists of the network version byte and Hash224 of the public key
+	// and has Base58Check string representation.
+	Address struct {
+		version byte
+		hash    Hash224
+	}
+)
+
+// NewAddress makes the address of the public key for the network version byte.
+// The version byte has to be registered in the networks registry.
+func NewAddress(pbKey PublicKey, ver byte) (Address, error) {
+	if pbKey == nil {
+		return Address{}, ErrPublicKeyCannotBeNil()
+	}
+
+	if _, ok := GetNetworkByVersion(ver); !ok {
+		return Address{}, ErrUnknownNetwork()
+	}
+
+	h224, err := pbKey.Hash224()
+	if err != nil {
+		return Address{}, err
+	}
+
+	return Address{version: ver, hash: h224}, nil
+}
+
+// NewNetworkAddress makes the address of the public key for the named network.
+func NewNetworkAddress(pbKey PublicKey, name string) (Address, error) {
+	network, ok := GetNetwork(name)
+	if !ok {
+		return Address{}, ErrUnknownNetwork()
+	}
+
+	return NewAddress(pbKey, network.Version)
+}
+
+// DecodeAddress decodes a protobuf encoded message.
+func DecodeAddress(pbuf *pb.Address) (Address, error) {
+	addr := Address{}
+	if err := addr.Decode(pbuf); err != nil {
+		return Address{}, err
+	}
+
+	return addr, nil
+}
+
+// ParseAddress decodes Base58Check string and validates the address,
+// the checksum, the hash length and the network version byte.
+func ParseAddress(s string) (Address, error) {
+	blob, ver, err := base58.CheckDecode([]byte(s))
+	if err != nil {
+		return Address{}, err
+	}
+
+	if len(blob) != Hash224Size {
+		return Address{}, ErrInvalidAddress()
+	}
+
+	if _, ok := GetNetworkByVersion(ver); !ok {
+		return Address{}, ErrUnknownNetwork()
+	}
+
+	addr := Address{version: ver}
+	copy(addr.hash[:], blob)
+
+	return addr, nil
+}
+
+// ParseBech32Address decodes Bech32m string and validates the address,
+// the hash length and the network version byte.
+// The address is returned with the human-readable part.
+func ParseBech32Address(s string) (Address, string, error) {
+	hrp, blob, variant, err := bech32.DecodeBytes(s)
+	if err != nil {
+		return Address{}, "", err
+	}
+
+	if variant != bech32.Bech32m || len(blob) != 1+Hash224Size {
+		return Address{}, "", ErrInvalidAddress()
+	}
+
+	if _, ok := GetNetworkByVersion(blob[0]); !ok {
+		return Address{}, "", ErrUnknownNetwork()
+	}
+
+	addr := Address{version: blob[0]}
+	copy(addr.hash[:], blob[1:])
+
+	return addr, hrp, nil
+}
+
+// ValidateAddress returns an error if the string is not valid address.
+func ValidateAddress(s string) error {
+	_, err := ParseAddress(s)
+
+	return err
+}
+
+// Bech32 returns Bech32m encoded address with the human-readable part,
+// the encoded data is the network version byte followed by the hash.
+func (c Address) Bech32(hrp string) (string, error) {
+	blob := make([]byte, 0, 1+Hash224Size)
+	blob = append(blob, c.version)
+	blob = append(blob, c.hash[:]...)
+
+	return bech32.EncodeBytes(hrp, blob, bech32.Bech32m)
+}
+
+// Decode sets decoded data from protobuf message.
+func (c *Address) Decode(pbuf *pb.Address) error {
+	if pbuf == nil {
+		return errors.ErrNilPointerValue()
+	}
+
+	if pbuf.Version > math.MaxUint8 || len(pbuf.Hash) != Hash224Size {
+		return ErrInvalidAddress()
+	}
+
+	ver := byte(pbuf.Version)
+	if _, ok := GetNetworkByVersion(ver); !ok {
+		return ErrUnknownNetwork()
+	}
+
+	c.version = ver
+	copy(c.hash[:], pbuf.Hash)
+
+	return nil
+}
+
+// Empty returns true if the address hash is zeroed.
+func (c Address) Empty() bool {
+	return c.hash.Empty()
+}
+
+// Encode converts data to protobuf message.
+func (c Address) Encode() *pb.Address {
+	return &pb.Address{
+		Version: uint32(c.version),
+		Hash:    c.hash[:],
+	}
+}
+
+// Equals checks whether two addresses are the same.
+func (c Address) Equals(addr Address) bool {
+	return c == addr
+}
+
+// Hash returns Hash224 of the public key.
+func (c Address) Hash() Hash224 {
+	return c.hash
+}
+
+// Marshal implements marshaler interface for types
+// that can marshal themselves into bytes.
+func (c Address) Marshal() ([]byte, error) {
+	return proto.Marshal(c.Encode())
+}
+
+// MarshalJSON implements marshaler interface for types
+// that can marshal themselves into valid JSON.
+// The address is encoded as Base58Check string.
+func (c Address) MarshalJSON() ([]byte, error) {
+	return json.Marshal(c.String())
+}
+
+// Matches returns true if the address is derived from the public key.
+func (c Address) Matches(pbKey PublicKey) bool {
+	if pbKey == nil {
+		return false
+	}
+
+	h224, err := pbKey.Hash224()
+	if err != nil {
+		return false
+	}
+
+	return c.hash == h224
+}
+
+// Network returns registered network of the address.
+func (c Address) Network() (Network, bool) {
+	return GetNetworkByVersion(c.version)
+}
+
+// String implements stringer interface.
+// The address is encoded as Base58Check string.
+func (c Address) String() string {
+	return base58.CheckEncode(c.hash[:], c.version)
+}
+
+// Unmarshal implements unmarshaler interface for types
+// that can unmarshal bytes of themselves.
+func (c *Address) Unmarshal(b []byte) error {
+	pbuf := pb.Address{}
+	if err := proto.Unmarshal(b, &pbuf); err != nil {
+		return err
+	}
+
+	return c.Decode(&pbuf)
+}
+
+// UnmarshalJSON implements unmarshaler interface for types
+// that can unmarshal a JSON description of themselves.
+func (c *Address) UnmarshalJSON(b []byte) error {
+	s := ""
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+
+	addr, err := ParseAddress(s)
+	if err != nil {
+		return err
+	}
+
+	*c = addr
+
+	return nil
+}
+
+// Version returns the network version byte.
+func (c Address) Version() byte {
+	return c.version
+}
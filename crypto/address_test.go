@@ -0,0 +1,425 @@
+// Copyright © 2020-2021 The EVEN Solutions Developers Team
+
+package crypto_test
+
+import (
+	"testing"
+
+	"github.com/platsko/go-kit/base58"
+	"github.com/platsko/go-kit/bech32"
+	. "github.com/platsko/go-kit/crypto"
+	"github.com/platsko/go-kit/crypto/proto/pb"
+	"github.com/platsko/go-kit/errors"
+)
+
+func mockAddress(ver byte) (Address, PublicKey) {
+	_, pbKey := mockGenerateKeyPair(Ed25519)
+	addr, err := NewAddress(pbKey, ver)
+	if err != nil {
+		panic(err)
+	}
+
+	return addr, pbKey
+}
+
+func Benchmark_NewAddress(b *testing.B) {
+	_, pbKey := mockGenerateKeyPair(Ed25519)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := NewAddress(pbKey, MainNetVersion); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func Benchmark_ParseAddress(b *testing.B) {
+	addr, _ := mockAddress(MainNetVersion)
+	str := addr.String()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := ParseAddress(str); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func Test_NewAddress(t *testing.T) {
+	t.Parallel()
+
+	_, pbKey := mockGenerateKeyPair(Ed25519)
+	h224, err := pbKey.Hash224()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := [4]struct {
+		name    string
+		pbKey   PublicKey
+		ver     byte
+		wantErr error
+	}{
+		{
+			name:  "MainNet_OK",
+			pbKey: pbKey,
+			ver:   MainNetVersion,
+		},
+		{
+			name:  "TestNet_OK",
+			pbKey: pbKey,
+			ver:   TestNetVersion,
+		},
+		{
+			name:    "unknown_network_ERR",
+			pbKey:   pbKey,
+			ver:     0xff,
+			wantErr: ErrUnknownNetwork(),
+		},
+		{
+			name:    "nil_PublicKey_ERR",
+			ver:     MainNetVersion,
+			wantErr: ErrPublicKeyCannotBeNil(),
+		},
+	}
+
+	for idx := range tests {
+		test := tests[idx]
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := NewAddress(test.pbKey, test.ver)
+			if !errors.Is(err, test.wantErr) {
+				t.Errorf("NewAddress() error: %v | want: %v", err, test.wantErr)
+				return
+			}
+			if test.wantErr != nil {
+				return
+			}
+			if got.Version() != test.ver || got.Hash() != h224 {
+				t.Errorf("NewAddress() got: %v | want: %v", got, base58.CheckEncode(h224[:], test.ver))
+			}
+			if !got.Matches(test.pbKey) {
+				t.Errorf("Matches() got: %v | want: %v", false, true)
+			}
+		})
+	}
+}
+
+func Test_NewNetworkAddress(t *testing.T) {
+	t.Parallel()
+
+	_, pbKey := mockGenerateKeyPair(Ed25519)
+	tests := [2]struct {
+		name    string
+		network string
+		want    byte
+		wantErr bool
+	}{
+		{
+			name:    "OK",
+			network: TestNet,
+			want:    TestNetVersion,
+		},
+		{
+			name:    "ERR",
+			network: "unknown",
+			wantErr: true,
+		},
+	}
+
+	for idx := range tests {
+		test := tests[idx]
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := NewNetworkAddress(pbKey, test.network)
+			if (err != nil) != test.wantErr {
+				t.Errorf("NewNetworkAddress() error: %v | want: %v", err, test.wantErr)
+				return
+			}
+			if got.Version() != test.want {
+				t.Errorf("NewNetworkAddress() got: %v | want: %v", got.Version(), test.want)
+			}
+		})
+	}
+}
+
+func Test_ParseAddress(t *testing.T) {
+	t.Parallel()
+
+	addr, _ := mockAddress(MainNetVersion)
+	str := addr.String()
+	hash := addr.Hash()
+
+	tests := [5]struct {
+		name    string
+		str     string
+		want    Address
+		wantErr error
+	}{
+		{
+			name: "OK",
+			str:  str,
+			want: addr,
+		},
+		{
+			name:    "checksum_mismatch_ERR",
+			str:     base58.EncodeToString(append([]byte{MainNetVersion}, make([]byte, Hash224Size+base58.ChecksumSize)...)),
+			wantErr: base58.ErrChecksumMismatch(),
+		},
+		{
+			name:    "invalid_length_ERR",
+			str:     base58.CheckEncode(hash[1:], MainNetVersion),
+			wantErr: ErrInvalidAddress(),
+		},
+		{
+			name:    "unknown_network_ERR",
+			str:     base58.CheckEncode(hash[:], 0xff),
+			wantErr: ErrUnknownNetwork(),
+		},
+		{
+			name:    "invalid_format_ERR",
+			str:     "0OIl",
+			wantErr: base58.ErrUnknownFormat(),
+		},
+	}
+
+	for idx := range tests {
+		test := tests[idx]
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := ParseAddress(test.str)
+			if !errors.Is(err, test.wantErr) {
+				t.Errorf("ParseAddress() error: %v | want: %v", err, test.wantErr)
+				return
+			}
+			if !got.Equals(test.want) {
+				t.Errorf("ParseAddress() got: %v | want: %v", got, test.want)
+			}
+			if err = ValidateAddress(test.str); !errors.Is(err, test.wantErr) {
+				t.Errorf("ValidateAddress() error: %v | want: %v", err, test.wantErr)
+			}
+		})
+	}
+}
+
+func Test_Address_Matches(t *testing.T) {
+	t.Parallel()
+
+	addr, pbKey := mockAddress(MainNetVersion)
+	_, other := mockGenerateKeyPair(Ed25519)
+
+	tests := [3]struct {
+		name  string
+		pbKey PublicKey
+		want  bool
+	}{
+		{
+			name:  "TRUE",
+			pbKey: pbKey,
+			want:  true,
+		},
+		{
+			name:  "FALSE",
+			pbKey: other,
+		},
+		{
+			name: "nil_PublicKey_FALSE",
+		},
+	}
+
+	for idx := range tests {
+		test := tests[idx]
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := addr.Matches(test.pbKey); got != test.want {
+				t.Errorf("Matches() got: %v | want: %v", got, test.want)
+			}
+		})
+	}
+}
+
+func Test_Address_Network(t *testing.T) {
+	t.Parallel()
+
+	addr, _ := mockAddress(TestNetVersion)
+	want := Network{Name: TestNet, Version: TestNetVersion}
+	if got, ok := addr.Network(); !ok || got != want {
+		t.Errorf("Network() got: %v | want: %v", got, want)
+	}
+}
+
+func Test_Address_Decode(t *testing.T) {
+	t.Parallel()
+
+	addr, _ := mockAddress(MainNetVersion)
+	hash := addr.Hash()
+
+	tests := [5]struct {
+		name    string
+		pbuf    *pb.Address
+		want    Address
+		wantErr error
+	}{
+		{
+			name: "OK",
+			pbuf: addr.Encode(),
+			want: addr,
+		},
+		{
+			name:    "invalid_hash_ERR",
+			pbuf:    &pb.Address{Hash: hash[1:]},
+			wantErr: ErrInvalidAddress(),
+		},
+		{
+			name:    "invalid_version_ERR",
+			pbuf:    &pb.Address{Version: 0x100, Hash: hash[:]},
+			wantErr: ErrInvalidAddress(),
+		},
+		{
+			name:    "unknown_network_ERR",
+			pbuf:    &pb.Address{Version: 0xff, Hash: hash[:]},
+			wantErr: ErrUnknownNetwork(),
+		},
+		{
+			name:    "nil_ERR",
+			wantErr: errors.ErrNilPointerValue(),
+		},
+	}
+
+	for idx := range tests {
+		test := tests[idx]
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := DecodeAddress(test.pbuf)
+			if !errors.Is(err, test.wantErr) {
+				t.Errorf("DecodeAddress() error: %v | want: %v", err, test.wantErr)
+				return
+			}
+			if !got.Equals(test.want) {
+				t.Errorf("DecodeAddress() got: %v | want: %v", got, test.want)
+			}
+		})
+	}
+}
+
+func Test_Address_Marshal(t *testing.T) {
+	t.Parallel()
+
+	addr, _ := mockAddress(TestNetVersion)
+
+	blob, err := addr.Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got := Address{}
+	if err = got.Unmarshal(blob); err != nil {
+		t.Errorf("Unmarshal() error: %v | want: %v", err, false)
+		return
+	}
+	if !got.Equals(addr) {
+		t.Errorf("Unmarshal() got: %v | want: %v", got, addr)
+	}
+	if err = got.Unmarshal([]byte{0xff}); err == nil {
+		t.Errorf("Unmarshal() error: %v | want: %v", err, true)
+	}
+}
+
+func Test_Address_MarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	addr, _ := mockAddress(MainNetVersion)
+
+	blob, err := addr.MarshalJSON()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := `"` + addr.String() + `"`; string(blob) != want {
+		t.Errorf("MarshalJSON() got: %s | want: %v", blob, want)
+	}
+
+	got := Address{}
+	if err = got.UnmarshalJSON(blob); err != nil {
+		t.Errorf("UnmarshalJSON() error: %v | want: %v", err, false)
+		return
+	}
+	if !got.Equals(addr) {
+		t.Errorf("UnmarshalJSON() got: %v | want: %v", got, addr)
+	}
+
+	for _, blob = range [][]byte{[]byte(`1`), []byte(`"invalid"`)} {
+		if err = got.UnmarshalJSON(blob); err == nil {
+			t.Errorf("UnmarshalJSON() error: %v | want: %v", err, true)
+		}
+	}
+}
+
+func Test_ParseBech32Address(t *testing.T) {
+	t.Parallel()
+
+	addr, _ := mockAddress(MainNetVersion)
+	str, err := addr.Bech32("kit")
+	if err != nil {
+		t.Fatalf("Bech32() error: %v | want: %v", err, nil)
+	}
+
+	hash := addr.Hash()
+	unknown, _ := bech32.EncodeBytes("kit", append([]byte{0xff}, hash[:]...), bech32.Bech32m)
+	variant, _ := bech32.EncodeBytes("kit", append([]byte{MainNetVersion}, hash[:]...), bech32.Bech32)
+	short, _ := bech32.EncodeBytes("kit", hash[:], bech32.Bech32m)
+
+	tests := [5]struct {
+		name    string
+		str     string
+		want    Address
+		wantErr error
+	}{
+		{
+			name: "OK",
+			str:  str,
+			want: addr,
+		},
+		{
+			name:    "unknown_network_ERR",
+			str:     unknown,
+			wantErr: ErrUnknownNetwork(),
+		},
+		{
+			name:    "Bech32_variant_ERR",
+			str:     variant,
+			wantErr: ErrInvalidAddress(),
+		},
+		{
+			name:    "short_ERR",
+			str:     short,
+			wantErr: ErrInvalidAddress(),
+		},
+		{
+			name:    "Base58Check_ERR",
+			str:     addr.String(),
+			wantErr: bech32.ErrMixedCase(),
+		},
+	}
+
+	for idx := range tests {
+		test := tests[idx]
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, hrp, err := ParseBech32Address(test.str)
+			if !errors.Is(err, test.wantErr) {
+				t.Errorf("ParseBech32Address() error: %v | want: %v", err, test.wantErr)
+				return
+			}
+			if test.wantErr != nil {
+				return
+			}
+			if !got.Equals(test.want) || hrp != "kit" {
+				t.Errorf("ParseBech32Address() got: %v %v | want: %v %v", got, hrp, test.want, "kit")
+			}
+		})
+	}
+}
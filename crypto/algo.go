@@ -3,6 +3,12 @@
 package crypto
 
 import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	json "github.com/json-iterator/go"
 	cc "github.com/libp2p/go-libp2p-core/crypto"
 )
 
@@ -36,14 +42,105 @@ type (
 	Algos map[string]Algo
 )
 
+var (
+	// algoSpecs maps supported algos to its names and signature properties.
+	// The signature size is the maximum size in bytes or zero
+	// when it depends on the key size.
+	algoSpecs = map[Algo]struct {
+		name          string
+		signatureSize int
+		deterministic bool
+		batchVerify   bool
+	}{
+		RSA:       {name: "RSA", deterministic: true},
+		Ed25519:   {name: "Ed25519", signatureSize: 64, deterministic: true},
+		Secp256k1: {name: "Secp256k1", signatureSize: 72, deterministic: true},
+		ECDSA:     {name: "ECDSA"},
+	}
+)
+
 // GetAlgos returns a list of all supported algos.
 func GetAlgos() Algos {
-	return Algos{
-		"RSA":       RSA,
-		"Ed25519":   Ed25519,
-		"Secp256k1": Secp256k1,
-		"ECDSA":     ECDSA,
+	list := make(Algos, len(algoSpecs))
+	for algo, spec := range algoSpecs {
+		list[spec.name] = algo
+	}
+
+	return list
+}
+
+// GetAlgoList returns a list of all supported algos
+// in stable order of its enum values.
+func GetAlgoList() []Algo {
+	return GetAlgos().List()
+}
+
+// ParseAlgo returns the algo by its case-insensitive name.
+func ParseAlgo(name string) (Algo, error) {
+	for algo, spec := range algoSpecs {
+		if strings.EqualFold(spec.name, name) {
+			return algo, nil
+		}
+	}
+
+	return UNKNOWN, ErrUnknownAlgo()
+}
+
+// IsDeterministic reports whether the algo signs the same message
+// with the same key to the same signature.
+func (c Algo) IsDeterministic() bool {
+	return algoSpecs[c].deterministic
+}
+
+// IsValid reports whether the algo is supported.
+func (c Algo) IsValid() bool {
+	_, ok := algoSpecs[c]
+
+	return ok
+}
+
+// MarshalJSON implements marshaler interface for types
+// that can marshal themselves into valid JSON.
+func (c Algo) MarshalJSON() ([]byte, error) {
+	if !c.IsValid() {
+		return nil, ErrUnknownAlgo()
+	}
+
+	return json.Marshal(c.String())
+}
+
+// MarshalText implements encoding.TextMarshaler interface.
+func (c Algo) MarshalText() ([]byte, error) {
+	if !c.IsValid() {
+		return nil, ErrUnknownAlgo()
 	}
+
+	return []byte(c.String()), nil
+}
+
+// SignatureSize returns the maximum signature size in bytes
+// or zero when it depends on the key size or the algo is not supported.
+func (c Algo) SignatureSize() int {
+	return algoSpecs[c].signatureSize
+}
+
+// String implements stringer interface.
+func (c Algo) String() string {
+	if spec, ok := algoSpecs[c]; ok {
+		return spec.name
+	}
+	if c == UNKNOWN {
+		return "UNKNOWN"
+	}
+
+	return fmt.Sprintf("Algo(%d)", c.Type())
+}
+
+// SupportsBatchVerify reports whether the algo allows
+// to verify a batch of signatures faster than one by one.
+// No algo supports it until the package provides a batch verify API.
+func (c Algo) SupportsBatchVerify() bool {
+	return algoSpecs[c].batchVerify
 }
 
 // Type returns int representation of the algo type.
@@ -51,6 +148,38 @@ func (c Algo) Type() int {
 	return int(c)
 }
 
+// UnmarshalJSON implements unmarshaler interface for types
+// that can unmarshal a JSON description of themselves.
+// The enum number is accepted as well as the algo name.
+func (c *Algo) UnmarshalJSON(blob []byte) error {
+	if num, err := strconv.Atoi(string(blob)); err == nil {
+		if algo := Algo(num); algo.IsValid() {
+			*c = algo
+			return nil
+		}
+
+		return ErrUnknownAlgo()
+	}
+
+	var name string
+	if err := json.Unmarshal(blob, &name); err != nil {
+		return err
+	}
+
+	return c.UnmarshalText([]byte(name))
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler interface.
+func (c *Algo) UnmarshalText(text []byte) error {
+	algo, err := ParseAlgo(string(text))
+	if err != nil {
+		return err
+	}
+	*c = algo
+
+	return nil
+}
+
 // Copy returns a copy of the algos list.
 func (c Algos) Copy() Algos {
 	list := make(Algos, c.Len())
@@ -65,3 +194,25 @@ func (c Algos) Copy() Algos {
 func (c Algos) Len() int {
 	return len(c)
 }
+
+// List returns the algos sorted by its enum values.
+func (c Algos) List() []Algo {
+	list := make([]Algo, 0, c.Len())
+	for _, algo := range c {
+		list = append(list, algo)
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i] < list[j] })
+
+	return list
+}
+
+// Names returns sorted names of the algos.
+func (c Algos) Names() []string {
+	list := make([]string, 0, c.Len())
+	for name := range c {
+		list = append(list, name)
+	}
+	sort.Strings(list)
+
+	return list
+}
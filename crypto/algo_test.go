@@ -3,10 +3,12 @@
 package crypto_test
 
 import (
+	"encoding/json"
 	"reflect"
 	"testing"
 
 	. "github.com/platsko/go-kit/crypto"
+	"github.com/platsko/go-kit/errors"
 )
 
 func Benchmark_GetAlgos(b *testing.B) {
@@ -161,3 +163,286 @@ func Test_Algos_Len(t *testing.T) {
 		})
 	}
 }
+
+func Benchmark_ParseAlgo(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		if _, err := ParseAlgo("ed25519"); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func Benchmark_Algo_String(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		_ = Ed25519.String()
+	}
+}
+
+func Test_GetAlgoList(t *testing.T) {
+	t.Parallel()
+
+	want := []Algo{RSA, Ed25519, Secp256k1, ECDSA}
+	if got := GetAlgoList(); !reflect.DeepEqual(got, want) {
+		t.Errorf("GetAlgoList() got: %#v | want: %#v", got, want)
+	}
+}
+
+func Test_ParseAlgo(t *testing.T) {
+	t.Parallel()
+
+	tests := [6]struct {
+		name    string
+		algo    string
+		want    Algo
+		wantErr error
+	}{
+		{
+			name: "RSA_OK",
+			algo: "RSA",
+			want: RSA,
+		},
+		{
+			name: "ed25519_lower_case_OK",
+			algo: "ed25519",
+			want: Ed25519,
+		},
+		{
+			name: "SECP256K1_upper_case_OK",
+			algo: "SECP256K1",
+			want: Secp256k1,
+		},
+		{
+			name: "ecdsa_lower_case_OK",
+			algo: "ecdsa",
+			want: ECDSA,
+		},
+		{
+			name:    "unknown_ERR",
+			algo:    "dsa",
+			want:    UNKNOWN,
+			wantErr: ErrUnknownAlgo(),
+		},
+		{
+			name:    "empty_ERR",
+			want:    UNKNOWN,
+			wantErr: ErrUnknownAlgo(),
+		},
+	}
+
+	for idx := range tests {
+		test := tests[idx]
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := ParseAlgo(test.algo)
+			if !errors.Is(err, test.wantErr) {
+				t.Errorf("ParseAlgo() error: %v | want: %v", err, test.wantErr)
+			}
+			if got != test.want {
+				t.Errorf("ParseAlgo() got: %v | want: %v", got, test.want)
+			}
+		})
+	}
+}
+
+func Test_Algo_String(t *testing.T) {
+	t.Parallel()
+
+	type (
+		testCase struct {
+			name string
+			algo Algo
+			want string
+		}
+		testList []testCase
+	)
+
+	algos := GetAlgos()
+	tests := make(testList, 0, algos.Len()+2)
+	for name, algo := range algos {
+		tests = append(tests, testCase{
+			name: name + "_OK",
+			algo: algo,
+			want: name,
+		})
+	}
+	tests = append(tests, testCase{
+		name: "UNKNOWN_OK",
+		algo: UNKNOWN,
+		want: "UNKNOWN",
+	}, testCase{
+		name: "unsupported_OK",
+		algo: Algo(42),
+		want: "Algo(42)",
+	})
+
+	for idx := range tests {
+		test := tests[idx]
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := test.algo.String(); got != test.want {
+				t.Errorf("String() got: %v | want: %v", got, test.want)
+			}
+			if got := test.algo.IsValid(); got != (test.algo == algos[test.want]) {
+				t.Errorf("IsValid() got: %v | want: %v", got, !got)
+			}
+		})
+	}
+}
+
+func Test_Algo_Capabilities(t *testing.T) {
+	t.Parallel()
+
+	tests := [5]struct {
+		name          string
+		algo          Algo
+		signatureSize int
+		deterministic bool
+		batchVerify   bool
+	}{
+		{
+			name:          "RSA_OK",
+			algo:          RSA,
+			deterministic: true,
+		},
+		{
+			name:          "Ed25519_OK",
+			algo:          Ed25519,
+			signatureSize: 64,
+			deterministic: true,
+		},
+		{
+			name:          "Secp256k1_OK",
+			algo:          Secp256k1,
+			signatureSize: 72,
+			deterministic: true,
+		},
+		{
+			name: "ECDSA_OK",
+			algo: ECDSA,
+		},
+		{
+			name: "UNKNOWN_OK",
+			algo: UNKNOWN,
+		},
+	}
+
+	for idx := range tests {
+		test := tests[idx]
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := test.algo.SignatureSize(); got != test.signatureSize {
+				t.Errorf("SignatureSize() got: %v | want: %v", got, test.signatureSize)
+			}
+			if got := test.algo.IsDeterministic(); got != test.deterministic {
+				t.Errorf("IsDeterministic() got: %v | want: %v", got, test.deterministic)
+			}
+			if got := test.algo.SupportsBatchVerify(); got != test.batchVerify {
+				t.Errorf("SupportsBatchVerify() got: %v | want: %v", got, test.batchVerify)
+			}
+		})
+	}
+}
+
+func Test_Algo_SignatureSize(t *testing.T) {
+	t.Parallel()
+
+	for name, algo := range GetAlgos() {
+		size := algo.SignatureSize()
+		if size == 0 {
+			continue
+		}
+
+		sign, _ := mockSignature(algo)
+		blob, err := sign.Raw()
+		if err != nil {
+			t.Fatalf("Raw() error: %v | want: %v", err, nil)
+		}
+		if len(blob) > size {
+			t.Errorf("%s SignatureSize() got: %v | want at least: %v", name, size, len(blob))
+		}
+	}
+}
+
+func Test_Algo_MarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	tests := [5]struct {
+		name    string
+		blob    string
+		want    Algo
+		wantErr bool
+	}{
+		{
+			name: "name_OK",
+			blob: `"Ed25519"`,
+			want: Ed25519,
+		},
+		{
+			name: "case_insensitive_OK",
+			blob: `"secp256k1"`,
+			want: Secp256k1,
+		},
+		{
+			name: "number_OK",
+			blob: `3`,
+			want: ECDSA,
+		},
+		{
+			name:    "unknown_number_ERR",
+			blob:    `42`,
+			wantErr: true,
+		},
+		{
+			name:    "unknown_name_ERR",
+			blob:    `"dsa"`,
+			wantErr: true,
+		},
+	}
+
+	for idx := range tests {
+		test := tests[idx]
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			var got Algo
+			if err := got.UnmarshalJSON([]byte(test.blob)); (err != nil) != test.wantErr {
+				t.Errorf("UnmarshalJSON() error: %v | want: %v", err, test.wantErr)
+				return
+			}
+			if test.wantErr {
+				return
+			}
+			if got != test.want {
+				t.Errorf("UnmarshalJSON() got: %v | want: %v", got, test.want)
+			}
+
+			blob, err := json.Marshal(struct{ Algo Algo }{Algo: got})
+			if err != nil {
+				t.Errorf("MarshalJSON() error: %v | want: %v", err, nil)
+				return
+			}
+			if want := `{"Algo":"` + test.want.String() + `"}`; string(blob) != want {
+				t.Errorf("MarshalJSON() got: %s | want: %s", blob, want)
+			}
+		})
+	}
+
+	if _, err := UNKNOWN.MarshalJSON(); !errors.Is(err, ErrUnknownAlgo()) {
+		t.Errorf("MarshalJSON() error: %v | want: %v", err, ErrUnknownAlgo())
+	}
+	if _, err := UNKNOWN.MarshalText(); !errors.Is(err, ErrUnknownAlgo()) {
+		t.Errorf("MarshalText() error: %v | want: %v", err, ErrUnknownAlgo())
+	}
+}
+
+func Test_Algos_Names(t *testing.T) {
+	t.Parallel()
+
+	want := []string{"ECDSA", "Ed25519", "RSA", "Secp256k1"}
+	if got := GetAlgos().Names(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Names() got: %#v | want: %#v", got, want)
+	}
+}
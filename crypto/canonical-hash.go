@@ -0,0 +1,35 @@
+// Copyright © 2020-2021 The EVEN Solutions Developers Team
+
+package crypto
+
+import (
+	"google.golang.org/protobuf/proto"
+
+	"github.com/platsko/go-kit/canonical"
+)
+
+// HashJSON calculates Hash256 over RFC 8785 canonical JSON of the value,
+// so the same logical object hashes the same on every node
+// regardless of map ordering and number formatting.
+// It is intended for use in Signable.Hash implementations.
+// Integers beyond canonical.MaxSafeInteger not exactly represented by double
+// are rejected, so int64 and uint64 fields of the value must be string-encoded.
+func HashJSON(v interface{}) (Hash256, error) {
+	blob, err := canonical.JSON(v)
+	if err != nil {
+		return Hash256{}, err
+	}
+
+	return NewHash256(blob), nil
+}
+
+// HashProto calculates Hash256 over deterministic protobuf encoding of the message.
+// It is intended for use in Signable.Hash implementations.
+func HashProto(msg proto.Message) (Hash256, error) {
+	blob, err := canonical.Proto(msg)
+	if err != nil {
+		return Hash256{}, err
+	}
+
+	return NewHash256(blob), nil
+}
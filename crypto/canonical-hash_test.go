@@ -0,0 +1,85 @@
+// Copyright © 2020-2021 The EVEN Solutions Developers Team
+
+package crypto_test
+
+import (
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/platsko/go-kit/canonical"
+	. "github.com/platsko/go-kit/crypto"
+	"github.com/platsko/go-kit/crypto/proto/pb"
+	"github.com/platsko/go-kit/errors"
+)
+
+func Benchmark_HashJSON(b *testing.B) {
+	value := map[string]interface{}{"name": "kit", "amount": 1.5, "tags": []string{"a", "b"}}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := HashJSON(value); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func Test_HashJSON(t *testing.T) {
+	t.Parallel()
+
+	type payload struct {
+		Name   string  `json:"name"`
+		Amount float64 `json:"amount"`
+	}
+
+	want := NewHash256([]byte(`{"amount":100,"name":"kit"}`))
+	values := [3]interface{}{
+		payload{Name: "kit", Amount: 1e2},
+		map[string]interface{}{"name": "kit", "amount": 100},
+		map[string]interface{}{"amount": 100.0, "name": "kit"},
+	}
+
+	for _, value := range values {
+		got, err := HashJSON(value)
+		if err != nil {
+			t.Errorf("HashJSON() error: %v | want: %v", err, nil)
+			continue
+		}
+		if got != want {
+			t.Errorf("HashJSON() got: %x | want: %x", got, want)
+		}
+	}
+
+	if _, err := HashJSON(make(chan int)); err == nil {
+		t.Errorf("HashJSON() error: %v | want: not nil", err)
+	}
+	if _, err := HashJSON(map[string]int64{"id": 1<<53 + 1}); !errors.Is(err, canonical.ErrInvalidNumber()) {
+		t.Errorf("HashJSON() error: %v | want: %v", err, canonical.ErrInvalidNumber())
+	}
+}
+
+func Test_HashProto(t *testing.T) {
+	t.Parallel()
+
+	pbKey := &pb.PublicKey{Blob: h256[:]}
+	blob, err := canonical.Proto(pbKey)
+	if err != nil {
+		t.Fatalf("Proto() error: %v | want: %v", err, nil)
+	}
+
+	got, err := HashProto(pbKey)
+	if err != nil {
+		t.Fatalf("HashProto() error: %v | want: %v", err, nil)
+	}
+	if want := NewHash256(blob); got != want {
+		t.Errorf("HashProto() got: %x | want: %x", got, want)
+	}
+
+	clone := proto.Clone(pbKey)
+	if again, _ := HashProto(clone); again != got {
+		t.Errorf("HashProto() got: %x | want: %x", again, got)
+	}
+
+	if _, err = HashProto(nil); !errors.Is(err, errors.ErrNilPointerValue()) {
+		t.Errorf("HashProto() error: %v | want: %v", err, errors.ErrNilPointerValue())
+	}
+}
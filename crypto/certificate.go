@@ -0,0 +1,540 @@
+// Copyright © 2020-2021 The EVEN Solutions Developers Team
+
+package crypto
+
+import (
+	"bytes"
+	"sort"
+	"sync"
+	"time"
+
+	json "github.com/json-iterator/go"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/platsko/go-kit/crypto/proto/pb"
+	"github.com/platsko/go-kit/errors"
+	"github.com/platsko/go-kit/timestamp"
+)
+
+const (
+	// certificateDomain separates certificate hashes
+	// from hashes of the other signable types.
+	certificateDomain = "kit.crypto.Certificate"
+)
+
+type (
+	// Certificate represents a compact delegation certificate
+	// where the issuer grants the capabilities to the subject key
+	// within the validity window. The issuer is referenced by
+	// Hash224 fingerprint of its public key to keep certificates short.
+	Certificate struct {
+		Subject      PublicKey
+		Issuer       Hash224
+		Capabilities []string
+		NotBefore    timestamp.Timestamp
+		NotAfter     timestamp.Timestamp
+		Sign         Signature
+	}
+
+	// CertChain represents ordered list of certificates
+	// starting from the certificate issued by a trust anchor
+	// and ending with the leaf certificate.
+	CertChain []*Certificate
+
+	// RevocationList represents thread-safe set
+	// of revoked certificates hash checksums.
+	RevocationList struct {
+		mutex   sync.RWMutex
+		revoked map[Hash256]struct{}
+	}
+)
+
+var (
+	// Make sure Certificate implements Signable interface.
+	_ Signable = (*Certificate)(nil)
+)
+
+// IssueCertificate makes the certificate granting the capabilities
+// to the subject key within the specified window and signs it by the issuer.
+// The capabilities are sorted and deduplicated before signing.
+func IssueCertificate(issuer Signer, subject PublicKey, caps []string, notBefore, notAfter time.Time) (*Certificate, error) {
+	if issuer == nil {
+		return nil, errors.ErrNilPointerValue()
+	}
+
+	if subject == nil {
+		return nil, ErrPublicKeyCannotBeNil()
+	}
+
+	if !notBefore.Before(notAfter) {
+		return nil, ErrInvalidValidity()
+	}
+
+	list, err := normalizeCapabilities(caps)
+	if err != nil {
+		return nil, err
+	}
+
+	cert := Certificate{
+		Subject:      subject,
+		Capabilities: list,
+		NotBefore:    timestamp.Timestamp{Time: notBefore},
+		NotAfter:     timestamp.Timestamp{Time: notAfter},
+	}
+
+	if _, err = issuer.Sign(&cert); err != nil {
+		return nil, err
+	}
+
+	if cert.Issuer.Empty() {
+		return nil, ErrPublicKeyCannotBeNil()
+	}
+
+	return &cert, nil
+}
+
+// DecodeCertificate decodes a protobuf encoded message.
+func DecodeCertificate(pbuf *pb.Certificate) (*Certificate, error) {
+	cert := Certificate{}
+	if err := cert.Decode(pbuf); err != nil {
+		return nil, err
+	}
+
+	return &cert, nil
+}
+
+// Decode sets decoded data from protobuf message.
+func (c *Certificate) Decode(pbuf *pb.Certificate) error {
+	if pbuf == nil {
+		return errors.ErrNilPointerValue()
+	}
+
+	var issuer Hash224
+	if err := issuer.UnmarshalBinary(pbuf.Issuer); err != nil {
+		return err
+	}
+
+	if pbuf.NotBefore == nil || pbuf.NotAfter == nil {
+		return ErrInvalidValidity()
+	}
+
+	notBefore, err := timestamp.DecodeTimestamp(pbuf.NotBefore)
+	if err != nil {
+		return err
+	}
+
+	notAfter, err := timestamp.DecodeTimestamp(pbuf.NotAfter)
+	if err != nil {
+		return err
+	}
+
+	var subject PublicKey
+	if pbuf.Subject != nil {
+		if subject, err = DecodePublicKey(pbuf.Subject); err != nil {
+			return err
+		}
+	}
+
+	var sign Signature
+	if pbuf.Sign != nil {
+		sign = DecodeSignature(pbuf.Sign)
+	}
+
+	c.Subject = subject
+	c.Issuer = issuer
+	c.Capabilities = append([]string(nil), pbuf.Capabilities...)
+	c.NotBefore = notBefore
+	c.NotAfter = notAfter
+	c.Sign = sign
+
+	return nil
+}
+
+// Encode converts data to protobuf message.
+func (c *Certificate) Encode() (*pb.Certificate, error) {
+	notBefore, err := c.NotBefore.Encode()
+	if err != nil {
+		return nil, err
+	}
+
+	notAfter, err := c.NotAfter.Encode()
+	if err != nil {
+		return nil, err
+	}
+
+	pbuf := pb.Certificate{
+		Issuer:       append([]byte(nil), c.Issuer[:]...),
+		Capabilities: append([]string(nil), c.Capabilities...),
+		NotBefore:    notBefore,
+		NotAfter:     notAfter,
+	}
+
+	if c.Subject != nil {
+		if pbuf.Subject, err = c.Subject.Encode(); err != nil {
+			return nil, err
+		}
+	}
+
+	if c.Sign != nil {
+		pbuf.Sign = c.Sign.Encode()
+	}
+
+	return &pbuf, nil
+}
+
+// GetSignature implements Signable.GetSignature method of interface.
+func (c *Certificate) GetSignature() Signature {
+	return c.Sign
+}
+
+// HasCapability reports whether the certificate grants the capability.
+func (c *Certificate) HasCapability(capability string) bool {
+	for _, granted := range c.Capabilities {
+		if granted == capability {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Hash implements Hasher.Hash method of interface.
+// Hash calculates SHA256 checksum over length-prefixed fields
+// of the certificate including the capabilities, except the signature.
+func (c *Certificate) Hash() (Hash256, error) {
+	notBefore, err := c.NotBefore.MarshalBinary()
+	if err != nil {
+		return Hash256{}, err
+	}
+
+	notAfter, err := c.NotAfter.MarshalBinary()
+	if err != nil {
+		return Hash256{}, err
+	}
+
+	var subject []byte
+	if c.Subject != nil {
+		if subject, err = c.Subject.Raw(); err != nil {
+			return Hash256{}, err
+		}
+	}
+
+	fields := make([][]byte, 0, 5+len(c.Capabilities))
+	fields = append(fields, []byte(certificateDomain), subject, c.Issuer[:], notBefore, notAfter)
+	for _, capability := range c.Capabilities {
+		fields = append(fields, []byte(capability))
+	}
+
+	return hashFields(fields...), nil
+}
+
+// Marshal implements marshaler interface for types
+// that can marshal themselves into bytes.
+func (c *Certificate) Marshal() ([]byte, error) {
+	pbuf, err := c.Encode()
+	if err != nil {
+		return nil, err
+	}
+
+	return proto.Marshal(pbuf)
+}
+
+// MarshalJSON implements marshaler interface for types
+// that can marshal themselves into valid JSON.
+func (c *Certificate) MarshalJSON() ([]byte, error) {
+	pbuf, err := c.Encode()
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(pbuf)
+}
+
+// SetPublicKey implements Signable.SetPublicKey method of interface.
+// It sets the issuer fingerprint, the issuer key itself is not stored.
+func (c *Certificate) SetPublicKey(pbKey PublicKey) {
+	c.Issuer = Hash224{}
+	if pbKey != nil {
+		c.Issuer, _ = pbKey.Hash224()
+	}
+}
+
+// SetSignature implements Signable.SetSignature method of interface.
+func (c *Certificate) SetSignature(sign Signature) {
+	c.Sign = sign
+}
+
+// Unmarshal implements unmarshaler interface for types
+// that can unmarshal bytes of themselves.
+func (c *Certificate) Unmarshal(b []byte) error {
+	pbuf := pb.Certificate{}
+	if err := proto.Unmarshal(b, &pbuf); err != nil {
+		return err
+	}
+
+	return c.Decode(&pbuf)
+}
+
+// UnmarshalJSON implements unmarshaler interface for types
+// that can unmarshal a JSON description of themselves.
+func (c *Certificate) UnmarshalJSON(b []byte) error {
+	pbuf := pb.Certificate{}
+	if err := json.Unmarshal(b, &pbuf); err != nil {
+		return err
+	}
+
+	return c.Decode(&pbuf)
+}
+
+// ValidAt reports whether the time is within the certificate validity window.
+func (c *Certificate) ValidAt(at time.Time) bool {
+	return !at.Before(c.NotBefore.Time) && at.Before(c.NotAfter.Time)
+}
+
+// Verify verifies the certificate signature by the issuer key.
+func (c *Certificate) Verify(issuer PublicKey) error {
+	if issuer == nil || c.Subject == nil {
+		return ErrPublicKeyCannotBeNil()
+	}
+
+	h224, err := issuer.Hash224()
+	if err != nil {
+		return err
+	}
+
+	if h224 != c.Issuer {
+		return ErrUntrustedIssuer()
+	}
+
+	ok, err := issuer.Verify(c)
+	if err != nil {
+		return err
+	}
+
+	if !ok {
+		return ErrInvalidSignature()
+	}
+
+	return nil
+}
+
+// DecodeCertChain decodes a protobuf encoded message.
+func DecodeCertChain(pbuf *pb.CertChain) (CertChain, error) {
+	if pbuf == nil {
+		return nil, errors.ErrNilPointerValue()
+	}
+
+	chain := make(CertChain, 0, len(pbuf.Certs))
+	for _, item := range pbuf.Certs {
+		cert, err := DecodeCertificate(item)
+		if err != nil {
+			return nil, err
+		}
+		chain = append(chain, cert)
+	}
+
+	return chain, nil
+}
+
+// Encode converts data to protobuf message.
+func (c CertChain) Encode() (*pb.CertChain, error) {
+	pbuf := pb.CertChain{Certs: make([]*pb.Certificate, 0, len(c))}
+	for _, cert := range c {
+		if cert == nil {
+			return nil, errors.ErrNilPointerValue()
+		}
+
+		item, err := cert.Encode()
+		if err != nil {
+			return nil, err
+		}
+		pbuf.Certs = append(pbuf.Certs, item)
+	}
+
+	return &pbuf, nil
+}
+
+// Marshal implements marshaler interface for types
+// that can marshal themselves into bytes.
+func (c CertChain) Marshal() ([]byte, error) {
+	pbuf, err := c.Encode()
+	if err != nil {
+		return nil, err
+	}
+
+	return proto.Marshal(pbuf)
+}
+
+// Unmarshal implements unmarshaler interface for types
+// that can unmarshal bytes of themselves.
+func (c *CertChain) Unmarshal(b []byte) error {
+	pbuf := pb.CertChain{}
+	if err := proto.Unmarshal(b, &pbuf); err != nil {
+		return err
+	}
+
+	chain, err := DecodeCertChain(&pbuf)
+	if err != nil {
+		return err
+	}
+
+	*c = chain
+
+	return nil
+}
+
+// Verify walks the chain from one of the trust anchors and returns the leaf certificate.
+// Each certificate has to be issued by the subject of the previous one,
+// be valid at the given time, must not be revoked and may only narrow
+// the capabilities granted by the previous certificate.
+// The revocation list is optional and may be nil.
+func (c CertChain) Verify(anchors []PublicKey, revoked *RevocationList, at time.Time) (*Certificate, error) {
+	if len(c) == 0 {
+		return nil, ErrCertChainBroken()
+	}
+
+	if c[0] == nil {
+		return nil, errors.ErrNilPointerValue()
+	}
+
+	issuer, err := findAnchor(anchors, c[0].Issuer)
+	if err != nil {
+		return nil, err
+	}
+
+	var prev *Certificate
+	for _, cert := range c {
+		if cert == nil {
+			return nil, errors.ErrNilPointerValue()
+		}
+
+		if err = cert.Verify(issuer); err != nil {
+			if errors.Is(err, ErrUntrustedIssuer()) {
+				return nil, ErrCertChainBroken()
+			}
+			return nil, err
+		}
+
+		if !cert.ValidAt(at) {
+			return nil, ErrCertNotValid()
+		}
+
+		h256, err := cert.Hash()
+		if err != nil {
+			return nil, err
+		}
+
+		if revoked != nil && revoked.IsRevoked(h256) {
+			return nil, ErrCertRevoked()
+		}
+
+		if prev != nil {
+			for _, capability := range cert.Capabilities {
+				if !prev.HasCapability(capability) {
+					return nil, ErrCapabilityNotGranted()
+				}
+			}
+		}
+
+		issuer, prev = cert.Subject, cert
+	}
+
+	return prev, nil
+}
+
+// NewRevocationList returns the revocation list
+// containing the given certificates hash checksums.
+func NewRevocationList(hashes ...Hash256) *RevocationList {
+	list := RevocationList{revoked: make(map[Hash256]struct{}, len(hashes))}
+	for _, h256 := range hashes {
+		list.revoked[h256] = struct{}{}
+	}
+
+	return &list
+}
+
+// Hashes returns sorted list of the revoked certificates hash checksums.
+func (c *RevocationList) Hashes() []Hash256 {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+
+	list := make([]Hash256, 0, len(c.revoked))
+	for h256 := range c.revoked {
+		list = append(list, h256)
+	}
+	sort.Slice(list, func(i, j int) bool {
+		return bytes.Compare(list[i][:], list[j][:]) < 0
+	})
+
+	return list
+}
+
+// IsRevoked reports whether the certificate hash checksum is revoked.
+func (c *RevocationList) IsRevoked(h256 Hash256) bool {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+
+	_, ok := c.revoked[h256]
+
+	return ok
+}
+
+// Len returns the number of revoked certificates.
+func (c *RevocationList) Len() int {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+
+	return len(c.revoked)
+}
+
+// Revoke adds the certificate hash checksum to the revocation list.
+func (c *RevocationList) Revoke(h256 Hash256) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if c.revoked == nil {
+		c.revoked = make(map[Hash256]struct{})
+	}
+	c.revoked[h256] = struct{}{}
+}
+
+// findAnchor returns the trust anchor matching the issuer fingerprint.
+func findAnchor(anchors []PublicKey, issuer Hash224) (PublicKey, error) {
+	for _, anchor := range anchors {
+		if anchor == nil {
+			continue
+		}
+
+		h224, err := anchor.Hash224()
+		if err != nil {
+			return nil, err
+		}
+
+		if h224 == issuer {
+			return anchor, nil
+		}
+	}
+
+	return nil, ErrUntrustedIssuer()
+}
+
+// normalizeCapabilities returns sorted list of unique capabilities.
+func normalizeCapabilities(caps []string) ([]string, error) {
+	list := make([]string, 0, len(caps))
+	for _, capability := range caps {
+		if capability == "" {
+			return nil, ErrInvalidCapability()
+		}
+		list = append(list, capability)
+	}
+	sort.Strings(list)
+
+	uniq := list[:0]
+	for _, capability := range list {
+		if len(uniq) == 0 || capability != uniq[len(uniq)-1] {
+			uniq = append(uniq, capability)
+		}
+	}
+
+	return uniq, nil
+}
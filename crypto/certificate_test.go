@@ -0,0 +1,443 @@
+// Copyright © 2020-2021 The EVEN Solutions Developers Team
+
+package crypto_test
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	. "github.com/platsko/go-kit/crypto"
+	"github.com/platsko/go-kit/crypto/proto/pb"
+	"github.com/platsko/go-kit/errors"
+)
+
+func mockCertificate(issuer PrivateKey, caps []string, now time.Time) (*Certificate, PrivateKey) {
+	prKey, pbKey := mockGenerateKeyPair(Ed25519)
+	cert, err := IssueCertificate(issuer, pbKey, caps, now.Add(-time.Minute), now.Add(time.Hour))
+	if err != nil {
+		panic(err)
+	}
+
+	return cert, prKey
+}
+
+func mockCertChain(now time.Time) (PublicKey, CertChain) {
+	root, anchor := mockGenerateKeyPair(Ed25519)
+	node, nodeKey := mockCertificate(root, []string{"read", "write", "admin"}, now)
+	session, _ := mockCertificate(nodeKey, []string{"read"}, now)
+
+	return anchor, CertChain{node, session}
+}
+
+func mockCertificateBlob(strip func(*pb.Certificate)) []byte {
+	prKey, _ := mockGenerateKeyPair(Ed25519)
+	cert, _ := mockCertificate(prKey, []string{"read"}, time.Now())
+	pbuf, err := cert.Encode()
+	if err != nil {
+		panic(err)
+	}
+	strip(pbuf)
+
+	blob, err := proto.Marshal(pbuf)
+	if err != nil {
+		panic(err)
+	}
+
+	return blob
+}
+
+func Benchmark_IssueCertificate(b *testing.B) {
+	prKey, _ := mockGenerateKeyPair(Ed25519)
+	_, subject := mockGenerateKeyPair(Ed25519)
+	caps, now := []string{"read", "write"}, time.Now()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := IssueCertificate(prKey, subject, caps, now, now.Add(time.Hour)); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func Benchmark_CertChain_Verify(b *testing.B) {
+	now := time.Now()
+	anchor, chain := mockCertChain(now)
+	anchors := []PublicKey{anchor}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := chain.Verify(anchors, nil, now); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func Test_IssueCertificate(t *testing.T) {
+	t.Parallel()
+
+	type (
+		testCase struct {
+			name      string
+			issuer    Signer
+			subject   PublicKey
+			caps      []string
+			notBefore time.Time
+			notAfter  time.Time
+			want      []string
+			wantErr   error
+		}
+		testList []testCase
+	)
+
+	now := time.Now()
+	algos := GetAlgos()
+	tests := make(testList, 0, algos.Len()+5)
+	for name, algo := range algos {
+		prKey, _ := mockGenerateKeyPair(algo)
+		_, subject := mockGenerateKeyPair(algo)
+		tests = append(tests, testCase{
+			name:      name + "_OK",
+			issuer:    prKey,
+			subject:   subject,
+			caps:      []string{"write", "read", "write"},
+			notBefore: now,
+			notAfter:  now.Add(time.Hour),
+			want:      []string{"read", "write"},
+		})
+	}
+
+	prKey, subject := mockGenerateKeyPair(Ed25519)
+	tests = append(tests, testCase{
+		name:      "no_capabilities_OK",
+		issuer:    prKey,
+		subject:   subject,
+		notBefore: now,
+		notAfter:  now.Add(time.Hour),
+		want:      []string{},
+	}, testCase{
+		name:      "nil_Signer_ERR",
+		subject:   subject,
+		notBefore: now,
+		notAfter:  now.Add(time.Hour),
+		wantErr:   errors.ErrNilPointerValue(),
+	}, testCase{
+		name:      "nil_PublicKey_ERR",
+		issuer:    prKey,
+		notBefore: now,
+		notAfter:  now.Add(time.Hour),
+		wantErr:   ErrPublicKeyCannotBeNil(),
+	}, testCase{
+		name:      "empty_capability_ERR",
+		issuer:    prKey,
+		subject:   subject,
+		caps:      []string{"read", ""},
+		notBefore: now,
+		notAfter:  now.Add(time.Hour),
+		wantErr:   ErrInvalidCapability(),
+	}, testCase{
+		name:      "invalid_validity_ERR",
+		issuer:    prKey,
+		subject:   subject,
+		notBefore: now,
+		notAfter:  now,
+		wantErr:   ErrInvalidValidity(),
+	})
+
+	for idx := range tests {
+		test := tests[idx]
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			cert, err := IssueCertificate(test.issuer, test.subject, test.caps, test.notBefore, test.notAfter)
+			if !errors.Is(err, test.wantErr) {
+				t.Errorf("IssueCertificate() error: %v | want: %v", err, test.wantErr)
+				return
+			}
+			if test.wantErr != nil {
+				return
+			}
+			if !reflect.DeepEqual(cert.Capabilities, test.want) {
+				t.Errorf("IssueCertificate() got: %#v | want: %#v", cert.Capabilities, test.want)
+			}
+			if err = cert.Verify(test.issuer.(PrivateKey).PublicKey()); err != nil {
+				t.Errorf("Verify() error: %v | want: %v", err, nil)
+			}
+		})
+	}
+}
+
+func Test_Certificate_HasCapability(t *testing.T) {
+	t.Parallel()
+
+	prKey, _ := mockGenerateKeyPair(Ed25519)
+	cert, _ := mockCertificate(prKey, []string{"read", "write"}, time.Now())
+
+	tests := [3]struct {
+		name       string
+		capability string
+		want       bool
+	}{
+		{
+			name:       "read_TRUE",
+			capability: "read",
+			want:       true,
+		},
+		{
+			name:       "write_TRUE",
+			capability: "write",
+			want:       true,
+		},
+		{
+			name:       "admin_FALSE",
+			capability: "admin",
+		},
+	}
+
+	for idx := range tests {
+		test := tests[idx]
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := cert.HasCapability(test.capability); got != test.want {
+				t.Errorf("HasCapability() got: %v | want: %v", got, test.want)
+			}
+		})
+	}
+}
+
+func Test_Certificate_Marshal(t *testing.T) {
+	t.Parallel()
+
+	prKey, pbKey := mockGenerateKeyPair(Secp256k1)
+	cert, _ := mockCertificate(prKey, []string{"read", "write"}, time.Now())
+
+	blob, err := cert.Marshal()
+	if err != nil {
+		t.Fatalf("Marshal() error: %v | want: %v", err, nil)
+	}
+
+	got := Certificate{}
+	if err = got.Unmarshal(blob); err != nil {
+		t.Fatalf("Unmarshal() error: %v | want: %v", err, nil)
+	}
+	if err = got.Verify(pbKey); err != nil {
+		t.Errorf("Verify() error: %v | want: %v", err, nil)
+	}
+	if got.Issuer != cert.Issuer || !reflect.DeepEqual(got.Capabilities, cert.Capabilities) {
+		t.Errorf("Unmarshal() got: %#v | want: %#v", got, cert)
+	}
+
+	blob, err = cert.MarshalJSON()
+	if err != nil {
+		t.Fatalf("MarshalJSON() error: %v | want: %v", err, nil)
+	}
+
+	got = Certificate{}
+	if err = got.UnmarshalJSON(blob); err != nil {
+		t.Fatalf("UnmarshalJSON() error: %v | want: %v", err, nil)
+	}
+	if err = got.Verify(pbKey); err != nil {
+		t.Errorf("Verify() error: %v | want: %v", err, nil)
+	}
+}
+
+func Test_Certificate_Unmarshal_ERR(t *testing.T) {
+	t.Parallel()
+
+	tests := [2]struct {
+		name    string
+		blob    []byte
+		wantErr error
+	}{
+		{
+			name:    "no_not_before_ERR",
+			blob:    mockCertificateBlob(func(pbuf *pb.Certificate) { pbuf.NotBefore = nil }),
+			wantErr: ErrInvalidValidity(),
+		},
+		{
+			name:    "no_not_after_ERR",
+			blob:    mockCertificateBlob(func(pbuf *pb.Certificate) { pbuf.NotAfter = nil }),
+			wantErr: ErrInvalidValidity(),
+		},
+	}
+
+	for idx := range tests {
+		test := tests[idx]
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			if err := new(Certificate).Unmarshal(test.blob); !errors.Is(err, test.wantErr) {
+				t.Errorf("Unmarshal() error: %v | want: %v", err, test.wantErr)
+			}
+		})
+	}
+}
+
+func Test_CertChain_Marshal(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	anchor, chain := mockCertChain(now)
+
+	blob, err := chain.Marshal()
+	if err != nil {
+		t.Fatalf("Marshal() error: %v | want: %v", err, nil)
+	}
+
+	got := CertChain{}
+	if err = got.Unmarshal(blob); err != nil {
+		t.Fatalf("Unmarshal() error: %v | want: %v", err, nil)
+	}
+
+	leaf, err := got.Verify([]PublicKey{anchor}, nil, now)
+	if err != nil {
+		t.Fatalf("Verify() error: %v | want: %v", err, nil)
+	}
+	if !leaf.Subject.Equals(chain[1].Subject) {
+		t.Errorf("Verify() got: %#v | want: %#v", leaf.Subject, chain[1].Subject)
+	}
+}
+
+func Test_CertChain_Verify(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	anchor, chain := mockCertChain(now)
+	_, stranger := mockGenerateKeyPair(Ed25519)
+	anchors := []PublicKey{stranger, anchor}
+
+	h256, _ := chain[1].Hash()
+	revoked := NewRevocationList(h256)
+
+	rootKey, other := mockGenerateKeyPair(Ed25519)
+	node, nodeKey := mockCertificate(rootKey, []string{"read"}, now)
+	escalated, _ := mockCertificate(nodeKey, []string{"read", "admin"}, now)
+
+	_, foreign := mockCertChain(now)
+
+	tampered := *chain[1]
+	tampered.Capabilities = []string{"admin", "read"}
+
+	tests := [9]struct {
+		name    string
+		anchors []PublicKey
+		chain   CertChain
+		revoked *RevocationList
+		at      time.Time
+		want    *Certificate
+		wantErr error
+	}{
+		{
+			name:    "OK",
+			anchors: anchors,
+			chain:   chain,
+			revoked: NewRevocationList(),
+			at:      now,
+			want:    chain[1],
+		},
+		{
+			name:    "anchor_issued_OK",
+			anchors: anchors,
+			chain:   chain[:1],
+			revoked: revoked,
+			at:      now,
+			want:    chain[0],
+		},
+		{
+			name:    "empty_chain_ERR",
+			anchors: anchors,
+			at:      now,
+			wantErr: ErrCertChainBroken(),
+		},
+		{
+			name:    "untrusted_issuer_ERR",
+			anchors: []PublicKey{stranger},
+			chain:   chain,
+			at:      now,
+			wantErr: ErrUntrustedIssuer(),
+		},
+		{
+			name:    "broken_chain_ERR",
+			anchors: anchors,
+			chain:   CertChain{chain[0], foreign[1]},
+			at:      now,
+			wantErr: ErrCertChainBroken(),
+		},
+		{
+			name:    "tampered_ERR",
+			anchors: anchors,
+			chain:   CertChain{chain[0], &tampered},
+			at:      now,
+			wantErr: ErrInvalidSignature(),
+		},
+		{
+			name:    "escalated_capability_ERR",
+			anchors: []PublicKey{other},
+			chain:   CertChain{node, escalated},
+			at:      now,
+			wantErr: ErrCapabilityNotGranted(),
+		},
+		{
+			name:    "revoked_ERR",
+			anchors: anchors,
+			chain:   chain,
+			revoked: revoked,
+			at:      now,
+			wantErr: ErrCertRevoked(),
+		},
+		{
+			name:    "expired_ERR",
+			anchors: anchors,
+			chain:   chain,
+			at:      now.Add(time.Hour),
+			wantErr: ErrCertNotValid(),
+		},
+	}
+
+	for idx := range tests {
+		test := tests[idx]
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := test.chain.Verify(test.anchors, test.revoked, test.at)
+			if !errors.Is(err, test.wantErr) {
+				t.Errorf("Verify() error: %v | want: %v", err, test.wantErr)
+				return
+			}
+			if got != test.want {
+				t.Errorf("Verify() got: %#v | want: %#v", got, test.want)
+			}
+		})
+	}
+}
+
+func Test_RevocationList(t *testing.T) {
+	t.Parallel()
+
+	first, second := StrToHash256("first"), StrToHash256("second")
+	list := NewRevocationList(first, first)
+	if got := list.Len(); got != 1 {
+		t.Errorf("Len() got: %v | want: %v", got, 1)
+	}
+
+	list.Revoke(second)
+	if !list.IsRevoked(first) || !list.IsRevoked(second) {
+		t.Errorf("IsRevoked() got: %v | want: %v", false, true)
+	}
+	if list.IsRevoked(StrToHash256("third")) {
+		t.Errorf("IsRevoked() got: %v | want: %v", true, false)
+	}
+
+	var zero RevocationList
+	zero.Revoke(first)
+	if !zero.IsRevoked(first) {
+		t.Errorf("IsRevoked() got: %v | want: %v", false, true)
+	}
+
+	hashes := list.Hashes()
+	if len(hashes) != 2 || bytes.Compare(hashes[0][:], hashes[1][:]) >= 0 {
+		t.Errorf("Hashes() got: %v | want sorted: %v", hashes, []Hash256{first, second})
+	}
+}
@@ -0,0 +1,255 @@
+// Copyright © 2020-2021 The EVEN Solutions Developers Team
+
+package crypto
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/binary"
+	"hash"
+
+	"golang.org/x/crypto/blake2b"
+	"golang.org/x/crypto/sha3"
+
+	"github.com/platsko/go-kit/base58"
+)
+
+const (
+	// SHA2_256 is the multihash code of SHA2-256 hash algo.
+	SHA2_256 HashAlgo = 0x12
+
+	// SHA2_512 is the multihash code of SHA2-512 hash algo.
+	SHA2_512 HashAlgo = 0x13
+
+	// SHA3_256 is the multihash code of SHA3-256 hash algo.
+	SHA3_256 HashAlgo = 0x16
+
+	// KECCAK_256 is the multihash code of legacy Keccak-256 hash algo.
+	KECCAK_256 HashAlgo = 0x1b
+
+	// BLAKE2B_256 is the multihash code of BLAKE2b-256 hash algo.
+	BLAKE2B_256 HashAlgo = 0xb220
+)
+
+type (
+	// HashAlgo represents multihash code of the hash algo.
+	HashAlgo uint64
+
+	// Digest represents self-describing hash checksum
+	// in multihash format: uvarint algo code, uvarint
+	// checksum length and the checksum bytes.
+	Digest []byte
+)
+
+var (
+	// hashAlgos maps supported hash algos to its names and constructors.
+	hashAlgos = map[HashAlgo]struct {
+		name string
+		size int
+		new  func() hash.Hash
+	}{
+		SHA2_256:    {name: "sha2-256", size: sha256.Size, new: sha256.New},
+		SHA2_512:    {name: "sha2-512", size: sha512.Size, new: sha512.New},
+		SHA3_256:    {name: "sha3-256", size: 32, new: sha3.New256},
+		KECCAK_256:  {name: "keccak-256", size: 32, new: sha3.NewLegacyKeccak256},
+		BLAKE2B_256: {name: "blake2b-256", size: blake2b.Size256, new: newBlake2b256},
+	}
+)
+
+// newBlake2b256 returns unkeyed BLAKE2b-256 hash.
+func newBlake2b256() hash.Hash {
+	h, _ := blake2b.New256(nil) // error is never returned for nil key
+
+	return h
+}
+
+// IsValid returns true if the hash algo is supported.
+func (c HashAlgo) IsValid() bool {
+	_, ok := hashAlgos[c]
+
+	return ok
+}
+
+// New returns a new hash of the hash algo or nil if the algo is not supported.
+func (c HashAlgo) New() hash.Hash {
+	algo, ok := hashAlgos[c]
+	if !ok {
+		return nil
+	}
+
+	return algo.new()
+}
+
+// Size returns the checksum size in bytes of the hash algo.
+func (c HashAlgo) Size() int {
+	return hashAlgos[c].size
+}
+
+// String implements stringer interface.
+func (c HashAlgo) String() string {
+	algo, ok := hashAlgos[c]
+	if !ok {
+		return "unknown"
+	}
+
+	return algo.name
+}
+
+// NewDigest calculates checksum with the hash algo over specified bytes.
+func NewDigest(algo HashAlgo, data ...[]byte) (Digest, error) {
+	h := algo.New()
+	if h == nil {
+		return nil, ErrUnknownHashAlgo()
+	}
+
+	for _, blob := range data {
+		h.Write(blob) // never returns an error
+	}
+
+	return encodeDigest(algo, h.Sum(nil)), nil
+}
+
+// DigestFromHash256 converts Hash256 to SHA2-256 digest.
+func DigestFromHash256(h256 Hash256) Digest {
+	return encodeDigest(SHA2_256, h256[:])
+}
+
+// DecodeDigest validates multihash bytes and returns a copy as Digest.
+func DecodeDigest(blob []byte) (Digest, error) {
+	if _, _, err := decodeDigest(blob); err != nil {
+		return nil, err
+	}
+
+	digest := make(Digest, len(blob))
+	copy(digest, blob)
+
+	return digest, nil
+}
+
+// ParseDigest decodes base58 string to Digest.
+func ParseDigest(s string) (Digest, error) {
+	blob, err := base58.DecodeString(s)
+	if err != nil {
+		return nil, err
+	}
+
+	return DecodeDigest(blob)
+}
+
+// Algo returns the hash algo of the digest.
+func (c Digest) Algo() HashAlgo {
+	algo, _, err := decodeDigest(c)
+	if err != nil {
+		return 0
+	}
+
+	return algo
+}
+
+// Base58 returns base58 encoded string over the digest bytes.
+func (c Digest) Base58() string {
+	return base58.EncodeToString(c)
+}
+
+// Checksum returns the checksum bytes of the digest without prefix.
+func (c Digest) Checksum() []byte {
+	_, sum, err := decodeDigest(c)
+	if err != nil {
+		return nil
+	}
+
+	return sum
+}
+
+// Equals checks whether two digests are the same.
+func (c Digest) Equals(digest Digest) bool {
+	return bytes.Equal(c, digest)
+}
+
+// Hash256 converts SHA2-256 digest to Hash256.
+func (c Digest) Hash256() (Hash256, error) {
+	algo, sum, err := decodeDigest(c)
+	if err != nil {
+		return Hash256{}, err
+	}
+
+	if algo != SHA2_256 {
+		return Hash256{}, ErrHashAlgoMismatch()
+	}
+
+	h256 := Hash256{}
+	copy(h256[:], sum)
+
+	return h256, nil
+}
+
+// String implements stringer interface.
+func (c Digest) String() string {
+	return c.Base58()
+}
+
+// Validate returns an error if the digest is malformed or the algo is not supported.
+func (c Digest) Validate() error {
+	_, _, err := decodeDigest(c)
+
+	return err
+}
+
+// Verify recalculates the checksum over specified bytes
+// with the digest hash algo and compares it with the digest.
+func (c Digest) Verify(data ...[]byte) (bool, error) {
+	algo, _, err := decodeDigest(c)
+	if err != nil {
+		return false, err
+	}
+
+	digest, err := NewDigest(algo, data...)
+	if err != nil {
+		return false, err
+	}
+
+	return c.Equals(digest), nil
+}
+
+// encodeDigest prepends the algo code and the checksum length.
+func encodeDigest(algo HashAlgo, sum []byte) Digest {
+	digest := make(Digest, 0, 2*binary.MaxVarintLen64+len(sum))
+	digest = appendUvarint(digest, uint64(algo))
+	digest = appendUvarint(digest, uint64(len(sum)))
+
+	return append(digest, sum...)
+}
+
+// decodeDigest splits the digest to the algo code and the checksum.
+func decodeDigest(blob []byte) (HashAlgo, []byte, error) {
+	code, n := binary.Uvarint(blob)
+	if n <= 0 {
+		return 0, nil, ErrInvalidDigest()
+	}
+
+	size, m := binary.Uvarint(blob[n:])
+	if m <= 0 {
+		return 0, nil, ErrInvalidDigest()
+	}
+
+	algo := HashAlgo(code)
+	if !algo.IsValid() {
+		return 0, nil, ErrUnknownHashAlgo()
+	}
+
+	sum := blob[n+m:]
+	if size != uint64(algo.Size()) || uint64(len(sum)) != size {
+		return 0, nil, ErrInvalidDigest()
+	}
+
+	return algo, sum, nil
+}
+
+// appendUvarint appends uvarint encoded value to the buffer.
+func appendUvarint(buf []byte, v uint64) []byte {
+	var b [binary.MaxVarintLen64]byte
+	n := binary.PutUvarint(b[:], v)
+
+	return append(buf, b[:n]...)
+}
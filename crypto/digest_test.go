@@ -0,0 +1,215 @@
+// Copyright © 2020-2021 The EVEN Solutions Developers Team
+
+package crypto_test
+
+import (
+	"testing"
+
+	"github.com/platsko/go-kit/base58"
+	"github.com/platsko/go-kit/bytes"
+	. "github.com/platsko/go-kit/crypto"
+	"github.com/platsko/go-kit/errors"
+)
+
+func Benchmark_NewDigest(b *testing.B) {
+	blob := bytes.RandBytes(1024)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := NewDigest(SHA2_256, blob); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func Test_NewDigest(t *testing.T) {
+	t.Parallel()
+
+	// want values are the multihash base58 strings of "go-kit".
+	tests := [6]struct {
+		name    string
+		algo    HashAlgo
+		want    string
+		wantErr error
+	}{
+		{
+			name: "SHA2_256_OK",
+			algo: SHA2_256,
+			want: "QmRsf1Mzdyv5c1te9dnycvnztg6yPj5nkBbqBFjHtYi98R",
+		},
+		{
+			name: "SHA2_512_OK",
+			algo: SHA2_512,
+			want: "8Vta6SEA9TLX9PzaakcY4YZ5koxngyH3yKFJBoCPTetaSSVQb3XAbnfx36Yaxhe85cdFVrHivGKKk9y7zVpNbRv8wM",
+		},
+		{
+			name: "SHA3_256_OK",
+			algo: SHA3_256,
+			want: "W1nS5gbD6qK3qqxMFUranuGN83PpjnLrHrGJbDh1xdtcTZ",
+		},
+		{
+			name: "KECCAK_256_OK",
+			algo: KECCAK_256,
+			want: "cZwbS3igww9Tmoq3uwnzYDwXrHbqMisMEGpZ3UhAWmmW1P",
+		},
+		{
+			name: "BLAKE2B_256_OK",
+			algo: BLAKE2B_256,
+			want: "2DrjgbDBEiWcLX8oU9Qdm4yp733XuhLcHWWr3gqCaZWArywob2",
+		},
+		{
+			name:    "unknown_algo_ERR",
+			algo:    HashAlgo(0),
+			wantErr: ErrUnknownHashAlgo(),
+		},
+	}
+
+	for idx := range tests {
+		test := tests[idx]
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := NewDigest(test.algo, []byte("go-"), []byte("kit"))
+			if !errors.Is(err, test.wantErr) {
+				t.Errorf("NewDigest() error: %v | want: %v", err, test.wantErr)
+				return
+			}
+			if test.wantErr != nil {
+				return
+			}
+			if got.String() != test.want {
+				t.Errorf("NewDigest() got: %v | want: %v", got, test.want)
+			}
+			if got.Algo() != test.algo || len(got.Checksum()) != test.algo.Size() {
+				t.Errorf("NewDigest() got algo: %v | want: %v", got.Algo(), test.algo)
+			}
+			if ok, err := got.Verify([]byte("go-kit")); !ok || err != nil {
+				t.Errorf("Verify() got: %v, error: %v | want: %v", ok, err, true)
+			}
+		})
+	}
+}
+
+func Test_DigestFromHash256(t *testing.T) {
+	t.Parallel()
+
+	h256 := NewHash256([]byte("go-kit"))
+	digest := DigestFromHash256(h256)
+	if want := "QmRsf1Mzdyv5c1te9dnycvnztg6yPj5nkBbqBFjHtYi98R"; digest.String() != want {
+		t.Errorf("DigestFromHash256() got: %v | want: %v", digest, want)
+	}
+
+	got, err := digest.Hash256()
+	if err != nil {
+		t.Errorf("Hash256() error: %v | want: %v", err, false)
+		return
+	}
+	if got != h256 {
+		t.Errorf("Hash256() got: %v | want: %v", got.Encode(), h256.Encode())
+	}
+
+	other, _ := NewDigest(SHA3_256, []byte("go-kit"))
+	if _, err = other.Hash256(); !errors.Is(err, ErrHashAlgoMismatch()) {
+		t.Errorf("Hash256() error: %v | want: %v", err, ErrHashAlgoMismatch())
+	}
+}
+
+func Test_ParseDigest(t *testing.T) {
+	t.Parallel()
+
+	digest, _ := NewDigest(BLAKE2B_256, []byte("go-kit"))
+	tests := [6]struct {
+		name    string
+		str     string
+		wantErr error
+	}{
+		{
+			name: "OK",
+			str:  digest.String(),
+		},
+		{
+			name:    "truncated_ERR",
+			str:     base58.EncodeToString(digest[:len(digest)-1]),
+			wantErr: ErrInvalidDigest(),
+		},
+		{
+			name:    "wrong_length_ERR",
+			str:     base58.EncodeToString([]byte{0x12, 0x01, 0x00}),
+			wantErr: ErrInvalidDigest(),
+		},
+		{
+			name:    "unknown_algo_ERR",
+			str:     base58.EncodeToString([]byte{0x11, 0x01, 0x00}),
+			wantErr: ErrUnknownHashAlgo(),
+		},
+		{
+			name:    "empty_ERR",
+			wantErr: ErrInvalidDigest(),
+		},
+		{
+			name:    "invalid_base58_ERR",
+			str:     "0OIl",
+			wantErr: base58.ErrUnknownFormat(),
+		},
+	}
+
+	for idx := range tests {
+		test := tests[idx]
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := ParseDigest(test.str)
+			if !errors.Is(err, test.wantErr) {
+				t.Errorf("ParseDigest() error: %v | want: %v", err, test.wantErr)
+				return
+			}
+			if test.wantErr == nil && !got.Equals(digest) {
+				t.Errorf("ParseDigest() got: %v | want: %v", got, digest)
+			}
+		})
+	}
+}
+
+func Test_Digest_Validate(t *testing.T) {
+	t.Parallel()
+
+	digest, _ := NewDigest(SHA2_512, []byte("go-kit"))
+	if err := digest.Validate(); err != nil {
+		t.Errorf("Validate() error: %v | want: %v", err, false)
+	}
+
+	invalid := Digest{0x13, 0x40}
+	if err := invalid.Validate(); !errors.Is(err, ErrInvalidDigest()) {
+		t.Errorf("Validate() error: %v | want: %v", err, ErrInvalidDigest())
+	}
+	if invalid.Algo() != 0 || invalid.Checksum() != nil {
+		t.Errorf("Algo() got: %v | want: %v", invalid.Algo(), 0)
+	}
+	if _, err := invalid.Verify(); err == nil {
+		t.Errorf("Verify() error: %v | want: %v", err, true)
+	}
+}
+
+func Test_HashAlgo_String(t *testing.T) {
+	t.Parallel()
+
+	tests := [3]struct {
+		name string
+		algo HashAlgo
+		want string
+	}{
+		{name: "SHA2_256", algo: SHA2_256, want: "sha2-256"},
+		{name: "BLAKE2B_256", algo: BLAKE2B_256, want: "blake2b-256"},
+		{name: "unknown", algo: HashAlgo(0), want: "unknown"},
+	}
+
+	for idx := range tests {
+		test := tests[idx]
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := test.algo.String(); got != test.want {
+				t.Errorf("String() got: %v | want: %v", got, test.want)
+			}
+		})
+	}
+}
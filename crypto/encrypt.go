@@ -0,0 +1,316 @@
+// Copyright © 2020-2021 The EVEN Solutions Developers Team
+
+package crypto
+
+import (
+	"crypto/cipher"
+	"crypto/ecdh"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/sha512"
+	"io"
+	"math/big"
+
+	"github.com/btcsuite/btcd/btcec"
+	"golang.org/x/crypto/chacha20poly1305"
+	"golang.org/x/crypto/hkdf"
+)
+
+const (
+	// x25519Size is a size in bytes of X25519 keys and shared secrets.
+	x25519Size = 32
+
+	// encryptInfo is HKDF info string binding derived keys to this scheme.
+	encryptInfo = "go-kit/crypto/encrypt/v1"
+)
+
+var (
+	// curve25519P is the field prime of Curve25519: 2^255 - 19.
+	curve25519P = new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 255), big.NewInt(19))
+)
+
+// Encrypt encrypts the plaintext to the public key with an ephemeral key pair.
+// Secp256k1 and ECDSA keys use ECDH over the key curve (ECIES),
+// Ed25519 keys are converted to X25519 keys (sealed box).
+// The shared secret is expanded with HKDF-SHA256 and the plaintext is sealed
+// with ChaCha20-Poly1305. The result is the ephemeral public key followed
+// by the sealed plaintext, RSA keys are not supported.
+func Encrypt(pbKey PublicKey, plaintext []byte) ([]byte, error) {
+	key, err := PublicKeyToStd(pbKey)
+	if err != nil {
+		return nil, err
+	}
+
+	var ephemeral, secret []byte
+	switch std := key.(type) {
+	case *ecdsa.PublicKey:
+		ephemeral, secret, err = ecdhEphemeral(std)
+
+	case ed25519.PublicKey:
+		ephemeral, secret, err = x25519Ephemeral(std)
+
+	default:
+		return nil, ErrUnsupportedKeyType()
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	raw, err := pbKey.Raw()
+	if err != nil {
+		return nil, err
+	}
+
+	aead, err := encryptAEAD(secret, ephemeral, raw)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := make([]byte, aead.NonceSize()) // the key is never reused
+	ciphertext := make([]byte, 0, len(ephemeral)+len(plaintext)+aead.Overhead())
+	ciphertext = append(ciphertext, ephemeral...)
+
+	return aead.Seal(ciphertext, nonce, plaintext, nil), nil
+}
+
+// Decrypt decrypts the ciphertext produced by Encrypt with the private key.
+func Decrypt(prKey PrivateKey, ciphertext []byte) ([]byte, error) {
+	key, err := PrivateKeyToStd(prKey)
+	if err != nil {
+		return nil, err
+	}
+
+	var ephemeral, secret []byte
+	switch std := key.(type) {
+	case *ecdsa.PrivateKey:
+		size := ecdhPublicKeySize(std.Curve)
+		if len(ciphertext) < size {
+			return nil, ErrInvalidCiphertext()
+		}
+		ephemeral, ciphertext = ciphertext[:size], ciphertext[size:]
+		secret, err = ecdhShared(std, ephemeral)
+
+	case ed25519.PrivateKey:
+		if len(ciphertext) < x25519Size {
+			return nil, ErrInvalidCiphertext()
+		}
+		ephemeral, ciphertext = ciphertext[:x25519Size], ciphertext[x25519Size:]
+		secret, err = x25519Shared(std, ephemeral)
+
+	default:
+		return nil, ErrUnsupportedKeyType()
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	raw, err := prKey.PublicKey().Raw()
+	if err != nil {
+		return nil, err
+	}
+
+	aead, err := encryptAEAD(secret, ephemeral, raw)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(ciphertext) < aead.Overhead() {
+		return nil, ErrInvalidCiphertext()
+	}
+
+	nonce := make([]byte, aead.NonceSize())
+	plaintext, err := aead.Open(nil, nonce, ciphertext, nil)
+	if err != nil {
+		return nil, ErrDecryptionFailed()
+	}
+
+	return plaintext, nil
+}
+
+// encryptAEAD derives the AEAD key from the shared secret
+// bound to the ephemeral and the recipient public keys.
+func encryptAEAD(secret, ephemeral, recipient []byte) (cipher.AEAD, error) {
+	salt := make([]byte, 0, len(ephemeral)+len(recipient))
+	salt = append(salt, ephemeral...)
+	salt = append(salt, recipient...)
+
+	key := make([]byte, chacha20poly1305.KeySize)
+	if _, err := io.ReadFull(hkdf.New(sha256.New, secret, salt, []byte(encryptInfo)), key); err != nil {
+		return nil, err
+	}
+
+	return chacha20poly1305.New(key)
+}
+
+// ecdhPublicKeySize returns a size of uncompressed public key over the curve.
+func ecdhPublicKeySize(curve elliptic.Curve) int {
+	return 1 + 2*((curve.Params().BitSize+7)/8)
+}
+
+// ecdhEphemeral generates an ephemeral key pair over the recipient curve
+// and returns the ephemeral public key and ECDH shared secret.
+func ecdhEphemeral(recipient *ecdsa.PublicKey) ([]byte, []byte, error) {
+	if recipient.Curve == btcec.S256() {
+		return secp256k1Ephemeral(recipient)
+	}
+
+	pbKey, err := recipient.ECDH()
+	if err != nil {
+		return nil, nil, ErrUnsupportedKeyType()
+	}
+
+	ephemeral, err := pbKey.Curve().GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	secret, err := ephemeral.ECDH(pbKey)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return ephemeral.PublicKey().Bytes(), secret, nil
+}
+
+// ecdhShared returns x coordinate of the shared point
+// of the private key and uncompressed public key.
+func ecdhShared(prKey *ecdsa.PrivateKey, pbKey []byte) ([]byte, error) {
+	if prKey.Curve == btcec.S256() {
+		return secp256k1Shared(prKey, pbKey)
+	}
+
+	key, err := prKey.ECDH()
+	if err != nil {
+		return nil, ErrUnsupportedKeyType()
+	}
+
+	point, err := key.Curve().NewPublicKey(pbKey)
+	if err != nil {
+		return nil, ErrInvalidCiphertext()
+	}
+
+	secret, err := key.ECDH(point)
+	if err != nil {
+		return nil, ErrInvalidCiphertext()
+	}
+
+	return secret, nil
+}
+
+// secp256k1Ephemeral generates an ephemeral Secp256k1 key pair
+// and returns the ephemeral public key and ECDH shared secret,
+// the curve is not supported by crypto/ecdh.
+func secp256k1Ephemeral(recipient *ecdsa.PublicKey) ([]byte, []byte, error) {
+	ephemeral, err := btcec.NewPrivateKey(btcec.S256())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	pbKey := (*btcec.PublicKey)(recipient).SerializeUncompressed()
+	secret, err := secp256k1Shared(ephemeral.ToECDSA(), pbKey)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return ephemeral.PubKey().SerializeUncompressed(), secret, nil
+}
+
+// secp256k1Shared returns x coordinate of the shared point
+// of Secp256k1 private key and uncompressed public key
+// which is rejected unless it is on the curve.
+func secp256k1Shared(prKey *ecdsa.PrivateKey, pbKey []byte) ([]byte, error) {
+	point, err := btcec.ParsePubKey(pbKey, btcec.S256())
+	if err != nil {
+		return nil, ErrInvalidCiphertext()
+	}
+
+	x := btcec.GenerateSharedSecret((*btcec.PrivateKey)(prKey), point)
+	secret := make([]byte, btcec.PrivKeyBytesLen)
+	copy(secret[len(secret)-len(x):], x)
+
+	return secret, nil
+}
+
+// x25519Ephemeral generates an ephemeral X25519 key pair and returns
+// the ephemeral public key and the shared secret with Ed25519 recipient.
+func x25519Ephemeral(recipient ed25519.PublicKey) ([]byte, []byte, error) {
+	pbKey, err := ed25519PublicKeyToX25519(recipient)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ephemeral, err := ecdh.X25519().GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	secret, err := x25519(ephemeral, pbKey)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return ephemeral.PublicKey().Bytes(), secret, nil
+}
+
+// x25519Shared returns the shared secret
+// of Ed25519 private key and X25519 public key.
+func x25519Shared(prKey ed25519.PrivateKey, pbKey []byte) ([]byte, error) {
+	h := sha512.Sum512(prKey.Seed())
+	scalar, err := ecdh.X25519().NewPrivateKey(h[:x25519Size])
+	if err != nil {
+		return nil, err
+	}
+
+	return x25519(scalar, pbKey)
+}
+
+// x25519 multiplies the point by the scalar and rejects low order points
+// which produce the all-zero shared secret.
+func x25519(scalar *ecdh.PrivateKey, point []byte) ([]byte, error) {
+	pbKey, err := ecdh.X25519().NewPublicKey(point)
+	if err != nil {
+		return nil, ErrInvalidCiphertext()
+	}
+
+	secret, err := scalar.ECDH(pbKey)
+	if err != nil {
+		return nil, ErrInvalidCiphertext()
+	}
+
+	return secret, nil
+}
+
+// ed25519PublicKeyToX25519 converts Edwards point y coordinate
+// to Montgomery u coordinate: u = (1 + y) / (1 - y) mod p.
+func ed25519PublicKeyToX25519(pbKey ed25519.PublicKey) ([]byte, error) {
+	if len(pbKey) != ed25519.PublicKeySize {
+		return nil, ErrUnsupportedKeyType()
+	}
+
+	blob := make([]byte, ed25519.PublicKeySize) // big-endian y coordinate
+	for i, b := range pbKey {
+		blob[len(blob)-1-i] = b
+	}
+	blob[0] &= 0x7f // drop x sign bit
+
+	y := new(big.Int).SetBytes(blob)
+	den := new(big.Int).Sub(big.NewInt(1), y)
+	den.Mod(den, curve25519P)
+	if den.Sign() == 0 {
+		return nil, ErrUnsupportedKeyType()
+	}
+	den.ModInverse(den, curve25519P)
+
+	u := new(big.Int).Add(big.NewInt(1), y)
+	u.Mul(u, den).Mod(u, curve25519P)
+
+	u.FillBytes(blob) // little-endian u coordinate after reverse
+	for i, j := 0, len(blob)-1; i < j; i, j = i+1, j-1 {
+		blob[i], blob[j] = blob[j], blob[i]
+	}
+
+	return blob, nil
+}
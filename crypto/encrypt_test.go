@@ -0,0 +1,191 @@
+// Copyright © 2020-2021 The EVEN Solutions Developers Team
+
+package crypto_test
+
+import (
+	"testing"
+
+	"github.com/platsko/go-kit/bytes"
+	. "github.com/platsko/go-kit/crypto"
+	"github.com/platsko/go-kit/errors"
+)
+
+func Benchmark_Encrypt(b *testing.B) {
+	_, pbKey := mockGenerateKeyPair(Ed25519)
+	plaintext := bytes.RandBytes(1024)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := Encrypt(pbKey, plaintext); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func Benchmark_Decrypt(b *testing.B) {
+	prKey, pbKey := mockGenerateKeyPair(Ed25519)
+	ciphertext, err := Encrypt(pbKey, bytes.RandBytes(1024))
+	if err != nil {
+		b.Fatal(err)
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err = Decrypt(prKey, ciphertext); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func Test_Encrypt(t *testing.T) {
+	t.Parallel()
+
+	type (
+		testCase struct {
+			name    string
+			prKey   PrivateKey
+			pbKey   PublicKey
+			wantErr error
+		}
+		testList []testCase
+	)
+
+	algos := GetAlgos()
+	tests := make(testList, 0, algos.Len()+1)
+	for name, algo := range algos {
+		prKey, pbKey := mockGenerateKeyPair(algo)
+		test := testCase{
+			name:  name + "_OK",
+			prKey: prKey,
+			pbKey: pbKey,
+		}
+		if algo == RSA {
+			test.name, test.wantErr = name+"_ERR", ErrUnsupportedKeyType()
+		}
+		tests = append(tests, test)
+	}
+	tests = append(tests, testCase{
+		name:    "nil_PublicKey_ERR",
+		wantErr: ErrPublicKeyCannotBeNil(),
+	})
+
+	for idx := range tests {
+		test := tests[idx]
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			plaintext := bytes.RandBytes(128)
+			ciphertext, err := Encrypt(test.pbKey, plaintext)
+			if !errors.Is(err, test.wantErr) {
+				t.Errorf("Encrypt() error: %v | want: %v", err, test.wantErr)
+				return
+			}
+			if test.wantErr != nil {
+				return
+			}
+
+			got, err := Decrypt(test.prKey, ciphertext)
+			if err != nil {
+				t.Errorf("Decrypt() error: %v | want: %v", err, false)
+				return
+			}
+			if string(got) != string(plaintext) {
+				t.Errorf("Decrypt() got: %x | want: %x", got, plaintext)
+			}
+
+			again, err := Encrypt(test.pbKey, plaintext)
+			if err != nil || string(again) == string(ciphertext) {
+				t.Errorf("Encrypt() got the same ciphertext twice, error: %v", err)
+			}
+		})
+	}
+}
+
+func Test_Decrypt(t *testing.T) {
+	t.Parallel()
+
+	prKey, pbKey := mockGenerateKeyPair(Secp256k1)
+	otherKey, _ := mockGenerateKeyPair(Secp256k1)
+	edKey, edPbKey := mockGenerateKeyPair(Ed25519)
+	ecKey, _ := mockGenerateKeyPair(ECDSA)
+
+	offCurve := make([]byte, 128) // uncompressed point (0, 0)
+	offCurve[0] = 0x04
+
+	ciphertext, err := Encrypt(pbKey, []byte("secret"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	edCiphertext, err := Encrypt(edPbKey, []byte("secret"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tampered := append([]byte{}, ciphertext...)
+	tampered[len(tampered)-1] ^= 0xff
+
+	tests := [8]struct {
+		name       string
+		prKey      PrivateKey
+		ciphertext []byte
+		wantErr    error
+	}{
+		{
+			name:       "tampered_ERR",
+			prKey:      prKey,
+			ciphertext: tampered,
+			wantErr:    ErrDecryptionFailed(),
+		},
+		{
+			name:       "other_key_ERR",
+			prKey:      otherKey,
+			ciphertext: ciphertext,
+			wantErr:    ErrDecryptionFailed(),
+		},
+		{
+			name:       "short_ERR",
+			prKey:      prKey,
+			ciphertext: ciphertext[:10],
+			wantErr:    ErrInvalidCiphertext(),
+		},
+		{
+			name:       "no_tag_ERR",
+			prKey:      edKey,
+			ciphertext: edCiphertext[:32],
+			wantErr:    ErrInvalidCiphertext(),
+		},
+		{
+			name:       "low_order_point_ERR",
+			prKey:      edKey,
+			ciphertext: make([]byte, 64),
+			wantErr:    ErrInvalidCiphertext(),
+		},
+		{
+			name:       "Secp256k1_off_curve_point_ERR",
+			prKey:      prKey,
+			ciphertext: offCurve,
+			wantErr:    ErrInvalidCiphertext(),
+		},
+		{
+			name:       "ECDSA_off_curve_point_ERR",
+			prKey:      ecKey,
+			ciphertext: offCurve,
+			wantErr:    ErrInvalidCiphertext(),
+		},
+		{
+			name:       "nil_PrivateKey_ERR",
+			prKey:      NewPrivateKey(nil),
+			ciphertext: ciphertext,
+			wantErr:    errors.ErrNilPointerValue(),
+		},
+	}
+
+	for idx := range tests {
+		test := tests[idx]
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			if _, err := Decrypt(test.prKey, test.ciphertext); !errors.Is(err, test.wantErr) {
+				t.Errorf("Decrypt() error: %v | want: %v", err, test.wantErr)
+			}
+		})
+	}
+}
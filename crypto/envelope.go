@@ -0,0 +1,272 @@
+// Copyright © 2020-2021 The EVEN Solutions Developers Team
+
+package crypto
+
+import (
+	"time"
+
+	json "github.com/json-iterator/go"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/platsko/go-kit/crypto/proto/pb"
+	"github.com/platsko/go-kit/errors"
+	"github.com/platsko/go-kit/timestamp"
+)
+
+const (
+	// envelopeDomain separates envelope hashes
+	// from hashes of the other signable types.
+	envelopeDomain = "kit.crypto.Envelope"
+)
+
+type (
+	// Envelope represents signed payload with its type, creation
+	// and optional expiration time, the public key and the signature.
+	// The signature covers all fields of the envelope except itself.
+	Envelope struct {
+		PayloadType string
+		Payload     []byte
+		Created     timestamp.Timestamp
+		Expires     *timestamp.Timestamp
+		PbKey       PublicKey
+		Sign        Signature
+	}
+)
+
+var (
+	// Make sure Envelope implements Signable interface.
+	_ Signable = (*Envelope)(nil)
+)
+
+// Seal makes the envelope over the payload created at current time and signs it.
+// The envelope expires after the specified ttl, zero ttl means never expires.
+func Seal(signer Signer, payloadType string, payload []byte, ttl time.Duration) (*Envelope, error) {
+	if signer == nil {
+		return nil, errors.ErrNilPointerValue()
+	}
+
+	env := Envelope{
+		PayloadType: payloadType,
+		Payload:     payload,
+		Created:     timestamp.Now(),
+	}
+
+	if ttl > 0 {
+		env.Expires = &timestamp.Timestamp{Time: env.Created.Add(ttl)}
+	}
+
+	if _, err := signer.Sign(&env); err != nil {
+		return nil, err
+	}
+
+	return &env, nil
+}
+
+// Open verifies the envelope signature, payload type and expiration
+// at current time and returns the payload on success.
+func Open(env *Envelope, payloadType string) ([]byte, error) {
+	if env == nil {
+		return nil, errors.ErrNilPointerValue()
+	}
+
+	if env.PayloadType != payloadType {
+		return nil, ErrPayloadTypeMismatch()
+	}
+
+	if env.Expired(time.Now()) {
+		return nil, ErrEnvelopeExpired()
+	}
+
+	if env.PbKey == nil {
+		return nil, ErrPublicKeyCannotBeNil()
+	}
+
+	ok, err := env.PbKey.Verify(env)
+	if err != nil {
+		return nil, err
+	}
+
+	if !ok {
+		return nil, ErrInvalidSignature()
+	}
+
+	return env.Payload, nil
+}
+
+// DecodeEnvelope decodes a protobuf encoded message.
+func DecodeEnvelope(pbuf *pb.Envelope) (*Envelope, error) {
+	env := Envelope{}
+	if err := env.Decode(pbuf); err != nil {
+		return nil, err
+	}
+
+	return &env, nil
+}
+
+// Decode sets decoded data from protobuf message.
+func (c *Envelope) Decode(pbuf *pb.Envelope) error {
+	if pbuf == nil {
+		return errors.ErrNilPointerValue()
+	}
+
+	if pbuf.Created == nil {
+		return ErrInvalidEnvelope()
+	}
+
+	created, err := timestamp.DecodeTimestamp(pbuf.Created)
+	if err != nil {
+		return err
+	}
+
+	var expires *timestamp.Timestamp
+	if pbuf.Expires != nil {
+		ts, err := timestamp.DecodeTimestamp(pbuf.Expires)
+		if err != nil {
+			return err
+		}
+		expires = &ts
+	}
+
+	var pbKey PublicKey
+	if pbuf.Pbkey != nil {
+		if pbKey, err = DecodePublicKey(pbuf.Pbkey); err != nil {
+			return err
+		}
+	}
+
+	var sign Signature
+	if pbuf.Sign != nil {
+		sign = DecodeSignature(pbuf.Sign)
+	}
+
+	c.PayloadType = pbuf.PayloadType
+	c.Payload = pbuf.Payload
+	c.Created = created
+	c.Expires = expires
+	c.PbKey = pbKey
+	c.Sign = sign
+
+	return nil
+}
+
+// Encode converts data to protobuf message.
+func (c *Envelope) Encode() (*pb.Envelope, error) {
+	created, err := c.Created.Encode()
+	if err != nil {
+		return nil, err
+	}
+
+	pbuf := pb.Envelope{
+		PayloadType: c.PayloadType,
+		Payload:     c.Payload,
+		Created:     created,
+	}
+
+	if c.Expires != nil {
+		if pbuf.Expires, err = c.Expires.Encode(); err != nil {
+			return nil, err
+		}
+	}
+
+	if c.PbKey != nil {
+		if pbuf.Pbkey, err = c.PbKey.Encode(); err != nil {
+			return nil, err
+		}
+	}
+
+	if c.Sign != nil {
+		pbuf.Sign = c.Sign.Encode()
+	}
+
+	return &pbuf, nil
+}
+
+// Expired reports whether the envelope is expired at the given time.
+func (c *Envelope) Expired(at time.Time) bool {
+	return c.Expires != nil && !at.Before(c.Expires.Time)
+}
+
+// GetSignature implements Signable.GetSignature method of interface.
+func (c *Envelope) GetSignature() Signature {
+	return c.Sign
+}
+
+// Hash implements Hasher.Hash method of interface.
+// Hash calculates SHA256 checksum over length-prefixed fields
+// of the envelope including the public key, except the signature.
+func (c *Envelope) Hash() (Hash256, error) {
+	created, err := c.Created.MarshalBinary()
+	if err != nil {
+		return Hash256{}, err
+	}
+
+	var expires []byte
+	if c.Expires != nil {
+		if expires, err = c.Expires.MarshalBinary(); err != nil {
+			return Hash256{}, err
+		}
+	}
+
+	var pbKey []byte
+	if c.PbKey != nil {
+		if pbKey, err = c.PbKey.Raw(); err != nil {
+			return Hash256{}, err
+		}
+	}
+
+	return hashFields([]byte(envelopeDomain), []byte(c.PayloadType), c.Payload, created, expires, pbKey), nil
+}
+
+// Marshal implements marshaler interface for types
+// that can marshal themselves into bytes.
+func (c *Envelope) Marshal() ([]byte, error) {
+	pbuf, err := c.Encode()
+	if err != nil {
+		return nil, err
+	}
+
+	return proto.Marshal(pbuf)
+}
+
+// MarshalJSON implements marshaler interface for types
+// that can marshal themselves into valid JSON.
+func (c *Envelope) MarshalJSON() ([]byte, error) {
+	pbuf, err := c.Encode()
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(pbuf)
+}
+
+// SetPublicKey implements Signable.SetPublicKey method of interface.
+func (c *Envelope) SetPublicKey(pbKey PublicKey) {
+	c.PbKey = pbKey
+}
+
+// SetSignature implements Signable.SetSignature method of interface.
+func (c *Envelope) SetSignature(sign Signature) {
+	c.Sign = sign
+}
+
+// Unmarshal implements unmarshaler interface for types
+// that can unmarshal bytes of themselves.
+func (c *Envelope) Unmarshal(b []byte) error {
+	pbuf := pb.Envelope{}
+	if err := proto.Unmarshal(b, &pbuf); err != nil {
+		return err
+	}
+
+	return c.Decode(&pbuf)
+}
+
+// UnmarshalJSON implements unmarshaler interface for types
+// that can unmarshal a JSON description of themselves.
+func (c *Envelope) UnmarshalJSON(b []byte) error {
+	pbuf := pb.Envelope{}
+	if err := json.Unmarshal(b, &pbuf); err != nil {
+		return err
+	}
+
+	return c.Decode(&pbuf)
+}
@@ -0,0 +1,465 @@
+// Copyright © 2020-2021 The EVEN Solutions Developers Team
+
+package crypto_test
+
+import (
+	"crypto/ed25519"
+	"reflect"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/platsko/go-kit/bytes"
+	. "github.com/platsko/go-kit/crypto"
+	"github.com/platsko/go-kit/crypto/proto/pb"
+	"github.com/platsko/go-kit/errors"
+	"github.com/platsko/go-kit/timestamp"
+)
+
+const (
+	testPayloadType = "kit/test"
+)
+
+func mockEnvelope(algo Algo, ttl time.Duration) *Envelope {
+	prKey, _ := mockGenerateKeyPair(algo)
+	env, err := Seal(prKey, testPayloadType, bytes.RandBytes(1024), ttl)
+	if err != nil {
+		panic(err)
+	}
+
+	return env
+}
+
+func mockEnvelopeBlob(strip func(*pb.Envelope)) []byte {
+	pbuf, err := mockEnvelope(Ed25519, time.Minute).Encode()
+	if err != nil {
+		panic(err)
+	}
+	strip(pbuf)
+
+	blob, err := proto.Marshal(pbuf)
+	if err != nil {
+		panic(err)
+	}
+
+	return blob
+}
+
+func Benchmark_Seal(b *testing.B) {
+	prKey, _ := mockGenerateKeyPair(Ed25519)
+	payload := bytes.RandBytes(1024)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := Seal(prKey, testPayloadType, payload, time.Minute); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func Benchmark_Open(b *testing.B) {
+	env := mockEnvelope(Ed25519, time.Minute)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := Open(env, testPayloadType); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func Benchmark_Envelope_Marshal(b *testing.B) {
+	env := mockEnvelope(Ed25519, time.Minute)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := env.Marshal(); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func Benchmark_Envelope_Unmarshal(b *testing.B) {
+	blob, err := mockEnvelope(Ed25519, time.Minute).Marshal()
+	if err != nil {
+		b.Fatal(err)
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if err := new(Envelope).Unmarshal(blob); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func Test_Seal(t *testing.T) {
+	t.Parallel()
+
+	type (
+		testCase struct {
+			name    string
+			signer  Signer
+			ttl     time.Duration
+			wantErr bool
+		}
+		testList []testCase
+	)
+
+	algos := GetAlgos()
+	tests := make(testList, 0, algos.Len()+3)
+	for name, algo := range algos {
+		prKey, _ := mockGenerateKeyPair(algo)
+		tests = append(tests, testCase{
+			name:   name + "_OK",
+			signer: prKey,
+			ttl:    time.Minute,
+		})
+	}
+
+	prKey, _ := mockGenerateKeyPair(Ed25519)
+	tests = append(tests, testCase{
+		name:   "no_expiry_OK",
+		signer: prKey,
+	}, testCase{
+		name:    "nil_Signer_ERR",
+		wantErr: true,
+	}, testCase{
+		name:    "nil_pointer_PrivateKey_ERR",
+		signer:  NewPrivateKey(nil),
+		wantErr: true,
+	})
+
+	for idx := range tests {
+		test := tests[idx]
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			payload := bytes.RandBytes(1024)
+			env, err := Seal(test.signer, testPayloadType, payload, test.ttl)
+			if (err != nil) != test.wantErr {
+				t.Errorf("Seal() error: %v | want: %v", err, test.wantErr)
+				return
+			}
+			if test.wantErr {
+				return
+			}
+			if (env.Expires != nil) != (test.ttl > 0) {
+				t.Errorf("Seal() got expires: %v | want ttl: %v", env.Expires, test.ttl)
+			}
+
+			got, err := Open(env, testPayloadType)
+			if err != nil {
+				t.Errorf("Open() error: %v | want: %v", err, false)
+				return
+			}
+			if !reflect.DeepEqual(got, payload) {
+				t.Errorf("Open() got: %#v | want: %#v", got, payload)
+			}
+		})
+	}
+}
+
+func Test_Open(t *testing.T) {
+	t.Parallel()
+
+	expired := mockEnvelope(Ed25519, time.Minute)
+	expired.Expires.Time = expired.Created.Add(-time.Second)
+
+	tampered := mockEnvelope(Ed25519, time.Minute)
+	tampered.Payload = bytes.RandBytes(1024)
+
+	keyless := mockEnvelope(Ed25519, time.Minute)
+	keyless.PbKey = nil
+
+	_, notEq := mockGenerateKeyPair(Ed25519)
+	swapped := mockEnvelope(Ed25519, time.Minute)
+	swapped.PbKey = notEq
+
+	tests := [7]struct {
+		name        string
+		env         *Envelope
+		payloadType string
+		wantErr     error
+	}{
+		{
+			name:        "OK",
+			env:         mockEnvelope(Ed25519, time.Minute),
+			payloadType: testPayloadType,
+		},
+		{
+			name:        "nil_Envelope_ERR",
+			payloadType: testPayloadType,
+			wantErr:     errors.ErrNilPointerValue(),
+		},
+		{
+			name:        "payload_type_ERR",
+			env:         mockEnvelope(Ed25519, time.Minute),
+			payloadType: "kit/other",
+			wantErr:     ErrPayloadTypeMismatch(),
+		},
+		{
+			name:        "expired_ERR",
+			env:         expired,
+			payloadType: testPayloadType,
+			wantErr:     ErrEnvelopeExpired(),
+		},
+		{
+			name:        "tampered_ERR",
+			env:         tampered,
+			payloadType: testPayloadType,
+			wantErr:     ErrInvalidSignature(),
+		},
+		{
+			name:        "nil_PublicKey_ERR",
+			env:         keyless,
+			payloadType: testPayloadType,
+			wantErr:     ErrPublicKeyCannotBeNil(),
+		},
+		{
+			name:        "swapped_PublicKey_ERR",
+			env:         swapped,
+			payloadType: testPayloadType,
+			wantErr:     ErrInvalidSignature(),
+		},
+	}
+
+	for idx := range tests {
+		test := tests[idx]
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := Open(test.env, test.payloadType)
+			if !errors.Is(err, test.wantErr) {
+				t.Errorf("Open() error: %v | want: %v", err, test.wantErr)
+				return
+			}
+			if err == nil && !reflect.DeepEqual(got, test.env.Payload) {
+				t.Errorf("Open() got: %#v | want: %#v", got, test.env.Payload)
+			}
+		})
+	}
+}
+
+func Test_Envelope_Expired(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	tests := [3]struct {
+		name    string
+		expires *timestamp.Timestamp
+		want    bool
+	}{
+		{
+			name: "never_FALSE",
+		},
+		{
+			name:    "future_FALSE",
+			expires: &timestamp.Timestamp{Time: now.Add(time.Second)},
+		},
+		{
+			name:    "TRUE",
+			expires: &timestamp.Timestamp{Time: now},
+			want:    true,
+		},
+	}
+
+	for idx := range tests {
+		test := tests[idx]
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			env := Envelope{Expires: test.expires}
+			if got := env.Expired(now); got != test.want {
+				t.Errorf("Expired() got: %v | want: %v", got, test.want)
+			}
+		})
+	}
+}
+
+func Test_Envelope_Hash(t *testing.T) {
+	t.Parallel()
+
+	pbKey, err := PublicKeyFromStd(ed25519.NewKeyFromSeed(make([]byte, ed25519.SeedSize)).Public())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	created := timestamp.Timestamp{Time: time.Unix(1600000000, 0).UTC()}
+	expires := timestamp.Timestamp{Time: created.Add(time.Hour)}
+
+	tests := [3]struct {
+		name string
+		env  Envelope
+		want string
+	}{
+		{
+			name: "no_PbKey_OK",
+			env:  Envelope{PayloadType: testPayloadType, Payload: []byte("payload"), Created: created},
+			want: "b3edc2936fa02c68f96682c1e0ef82eec03dcc6a0f1f485217ef936218371da3",
+		},
+		{
+			name: "PbKey_OK",
+			env:  Envelope{PayloadType: testPayloadType, Payload: []byte("payload"), Created: created, PbKey: pbKey},
+			want: "cfe50840882b4f428990f081948b5acce295816a8ff85a647b84f962c0a19711",
+		},
+		{
+			name: "Expires_OK",
+			env: Envelope{
+				PayloadType: testPayloadType,
+				Payload:     []byte("payload"),
+				Created:     created,
+				Expires:     &expires,
+				PbKey:       pbKey,
+			},
+			want: "7f15d8e73998d1ecea40e4a1a1f6c7b41618bde6f0fac74ecfe4be1df28c7ae9",
+		},
+	}
+
+	for idx := range tests {
+		test := tests[idx]
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := test.env.Hash()
+			if err != nil {
+				t.Errorf("Hash() error: %v | want: %v", err, false)
+				return
+			}
+			if got.Hex() != test.want {
+				t.Errorf("Hash() got: %v | want: %v", got.Hex(), test.want)
+			}
+		})
+	}
+}
+
+func Test_Envelope_Marshal(t *testing.T) {
+	t.Parallel()
+
+	tests := [2]struct {
+		name string
+		env  *Envelope
+	}{
+		{
+			name: "OK",
+			env:  mockEnvelope(Secp256k1, time.Minute),
+		},
+		{
+			name: "no_expiry_OK",
+			env:  mockEnvelope(Secp256k1, 0),
+		},
+	}
+
+	for idx := range tests {
+		test := tests[idx]
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			blob, err := test.env.Marshal()
+			if err != nil {
+				t.Errorf("Marshal() error: %v | want: %v", err, false)
+				return
+			}
+
+			got := Envelope{}
+			if err = got.Unmarshal(blob); err != nil {
+				t.Errorf("Unmarshal() error: %v | want: %v", err, false)
+				return
+			}
+			if _, err = Open(&got, testPayloadType); err != nil {
+				t.Errorf("Open() error: %v | want: %v", err, false)
+			}
+		})
+	}
+}
+
+func Test_Envelope_Unmarshal_ERR(t *testing.T) {
+	t.Parallel()
+
+	tests := [2]struct {
+		name    string
+		blob    []byte
+		wantErr error
+	}{
+		{
+			name:    "empty_ERR",
+			blob:    []byte{},
+			wantErr: ErrInvalidEnvelope(),
+		},
+		{
+			name:    "no_created_ERR",
+			blob:    mockEnvelopeBlob(func(pbuf *pb.Envelope) { pbuf.Created = nil }),
+			wantErr: ErrInvalidEnvelope(),
+		},
+	}
+
+	for idx := range tests {
+		test := tests[idx]
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			if err := new(Envelope).Unmarshal(test.blob); !errors.Is(err, test.wantErr) {
+				t.Errorf("Unmarshal() error: %v | want: %v", err, test.wantErr)
+			}
+		})
+	}
+}
+
+func Test_Envelope_MarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	tests := [2]struct {
+		name string
+		env  *Envelope
+	}{
+		{
+			name: "OK",
+			env:  mockEnvelope(ECDSA, time.Minute),
+		},
+		{
+			name: "no_expiry_OK",
+			env:  mockEnvelope(ECDSA, 0),
+		},
+	}
+
+	for idx := range tests {
+		test := tests[idx]
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			blob, err := test.env.MarshalJSON()
+			if err != nil {
+				t.Errorf("MarshalJSON() error: %v | want: %v", err, false)
+				return
+			}
+
+			got := Envelope{}
+			if err = got.UnmarshalJSON(blob); err != nil {
+				t.Errorf("UnmarshalJSON() error: %v | want: %v", err, false)
+				return
+			}
+			if _, err = Open(&got, testPayloadType); err != nil {
+				t.Errorf("Open() error: %v | want: %v", err, false)
+			}
+		})
+	}
+}
+
+func Test_DecodeEnvelope(t *testing.T) {
+	t.Parallel()
+
+	env := mockEnvelope(Ed25519, time.Minute)
+	pbuf, err := env.Encode()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := DecodeEnvelope(pbuf)
+	if err != nil {
+		t.Fatalf("DecodeEnvelope() error: %v | want: %v", err, false)
+	}
+	if !got.PbKey.Equals(env.PbKey) || !got.Sign.Equals(env.Sign) || !got.Created.Equal(env.Created.Time) {
+		t.Errorf("DecodeEnvelope() got: %#v | want: %#v", got, env)
+	}
+
+	if _, err = DecodeEnvelope(nil); err == nil {
+		t.Errorf("DecodeEnvelope() error: %v | want: %v", err, true)
+	}
+}
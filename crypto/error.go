@@ -7,21 +7,197 @@ import (
 )
 
 const (
-	ErrPublicKeyCannotBeNilMsg = "public key cannot be nil"
-	ErrSignableCannotBeNilMsg  = "signable cannot be nil"
-	ErrSignatureCannotBeNilMsg = "signature cannot be nil"
+	ErrCapabilityNotGrantedMsg     = "capability is not granted by issuer"
+	ErrCertChainBrokenMsg          = "certificate chain broken"
+	ErrCertNotValidMsg             = "certificate is not valid"
+	ErrCertRevokedMsg              = "certificate is revoked"
+	ErrDecryptionFailedMsg         = "decryption failed"
+	ErrEncryptedSSHKeyMsg          = "encrypted ssh key is not supported"
+	ErrEnvelopeExpiredMsg          = "envelope expired"
+	ErrHashAlgoMismatchMsg         = "hash algo mismatch"
+	ErrHasherCannotBeNilMsg        = "hasher cannot be nil"
+	ErrInvalidAddressMsg           = "invalid address"
+	ErrInvalidCapabilityMsg        = "invalid capability"
+	ErrInvalidCiphertextMsg        = "invalid ciphertext"
+	ErrInvalidDigestMsg            = "invalid digest"
+	ErrInvalidEnvelopeMsg          = "invalid envelope"
+	ErrInvalidHashLengthMsg        = "invalid hash length"
+	ErrInvalidHashMsg              = "invalid hash"
+	ErrInvalidNetworkMsg           = "invalid network"
+	ErrInvalidPublicKeyMsg         = "invalid public key"
+	ErrInvalidSignatureMsg         = "invalid signature"
+	ErrInvalidSSHKeyMsg            = "invalid ssh key"
+	ErrInvalidSSHSignatureMsg      = "invalid ssh signature"
+	ErrInvalidValidityMsg          = "invalid validity window"
+	ErrNamespaceMismatchMsg        = "namespace mismatch"
+	ErrNetworkAlreadyRegisteredMsg = "network already registered"
+	ErrPayloadTypeMismatchMsg      = "payload type mismatch"
+	ErrPeerIDNotFoundMsg           = "peer id not found"
+	ErrPublicKeyCannotBeNilMsg     = "public key cannot be nil"
+	ErrRotationChainBrokenMsg      = "rotation chain broken"
+	ErrSignableCannotBeNilMsg      = "signable cannot be nil"
+	ErrSignatureCannotBeNilMsg     = "signature cannot be nil"
+	ErrUnknownAlgoMsg              = "unknown algo"
+	ErrUnknownHashAlgoMsg          = "unknown hash algo"
+	ErrUnknownNetworkMsg           = "unknown network"
+	ErrUnknownTextEncodingMsg      = "unknown text encoding"
+	ErrUnsupportedKeyTypeMsg       = "unsupported key type"
+	ErrUnsupportedScanTypeMsg      = "unsupported scan type"
+	ErrUntrustedIssuerMsg          = "untrusted certificate issuer"
 )
 
 var (
-	errPublicKeyCannotBeNil = errors.New(ErrPublicKeyCannotBeNilMsg)
-	errSignableCannotBeNil  = errors.New(ErrSignableCannotBeNilMsg)
-	errSignatureCannotBeNil = errors.New(ErrSignatureCannotBeNilMsg)
+	errCapabilityNotGranted     = errors.New(ErrCapabilityNotGrantedMsg)
+	errCertChainBroken          = errors.New(ErrCertChainBrokenMsg)
+	errCertNotValid             = errors.New(ErrCertNotValidMsg)
+	errCertRevoked              = errors.New(ErrCertRevokedMsg)
+	errDecryptionFailed         = errors.New(ErrDecryptionFailedMsg)
+	errEncryptedSSHKey          = errors.New(ErrEncryptedSSHKeyMsg)
+	errEnvelopeExpired          = errors.New(ErrEnvelopeExpiredMsg)
+	errHashAlgoMismatch         = errors.New(ErrHashAlgoMismatchMsg)
+	errHasherCannotBeNil        = errors.New(ErrHasherCannotBeNilMsg)
+	errInvalidAddress           = errors.New(ErrInvalidAddressMsg)
+	errInvalidCapability        = errors.New(ErrInvalidCapabilityMsg)
+	errInvalidCiphertext        = errors.New(ErrInvalidCiphertextMsg)
+	errInvalidDigest            = errors.New(ErrInvalidDigestMsg)
+	errInvalidEnvelope          = errors.New(ErrInvalidEnvelopeMsg)
+	errInvalidHash              = errors.New(ErrInvalidHashMsg)
+	errInvalidHashLength        = errors.New(ErrInvalidHashLengthMsg)
+	errInvalidNetwork           = errors.New(ErrInvalidNetworkMsg)
+	errInvalidPublicKey         = errors.New(ErrInvalidPublicKeyMsg)
+	errInvalidSignature         = errors.New(ErrInvalidSignatureMsg)
+	errInvalidSSHKey            = errors.New(ErrInvalidSSHKeyMsg)
+	errInvalidSSHSignature      = errors.New(ErrInvalidSSHSignatureMsg)
+	errInvalidValidity          = errors.New(ErrInvalidValidityMsg)
+	errNamespaceMismatch        = errors.New(ErrNamespaceMismatchMsg)
+	errNetworkAlreadyRegistered = errors.New(ErrNetworkAlreadyRegisteredMsg)
+	errPayloadTypeMismatch      = errors.New(ErrPayloadTypeMismatchMsg)
+	errPeerIDNotFound           = errors.New(ErrPeerIDNotFoundMsg)
+	errPublicKeyCannotBeNil     = errors.New(ErrPublicKeyCannotBeNilMsg)
+	errRotationChainBroken      = errors.New(ErrRotationChainBrokenMsg)
+	errSignableCannotBeNil      = errors.New(ErrSignableCannotBeNilMsg)
+	errSignatureCannotBeNil     = errors.New(ErrSignatureCannotBeNilMsg)
+	errUnknownAlgo              = errors.New(ErrUnknownAlgoMsg)
+	errUnknownHashAlgo          = errors.New(ErrUnknownHashAlgoMsg)
+	errUnknownNetwork           = errors.New(ErrUnknownNetworkMsg)
+	errUnknownTextEncoding      = errors.New(ErrUnknownTextEncodingMsg)
+	errUnsupportedKeyType       = errors.New(ErrUnsupportedKeyTypeMsg)
+	errUnsupportedScanType      = errors.New(ErrUnsupportedScanTypeMsg)
+	errUntrustedIssuer          = errors.New(ErrUntrustedIssuerMsg)
 )
 
+func ErrCapabilityNotGranted() error {
+	return errCapabilityNotGranted
+}
+
+func ErrCertChainBroken() error {
+	return errCertChainBroken
+}
+
+func ErrCertNotValid() error {
+	return errCertNotValid
+}
+
+func ErrCertRevoked() error {
+	return errCertRevoked
+}
+
+func ErrDecryptionFailed() error {
+	return errDecryptionFailed
+}
+
+func ErrEncryptedSSHKey() error {
+	return errEncryptedSSHKey
+}
+
+func ErrEnvelopeExpired() error {
+	return errEnvelopeExpired
+}
+
+func ErrHashAlgoMismatch() error {
+	return errHashAlgoMismatch
+}
+
+func ErrHasherCannotBeNil() error {
+	return errHasherCannotBeNil
+}
+
+func ErrInvalidAddress() error {
+	return errInvalidAddress
+}
+
+func ErrInvalidCapability() error {
+	return errInvalidCapability
+}
+
+func ErrInvalidCiphertext() error {
+	return errInvalidCiphertext
+}
+
+func ErrInvalidDigest() error {
+	return errInvalidDigest
+}
+
+func ErrInvalidEnvelope() error {
+	return errInvalidEnvelope
+}
+
+func ErrInvalidHash() error {
+	return errInvalidHash
+}
+
+func ErrInvalidHashLength() error {
+	return errInvalidHashLength
+}
+
+func ErrInvalidNetwork() error {
+	return errInvalidNetwork
+}
+
+func ErrInvalidPublicKey() error {
+	return errInvalidPublicKey
+}
+
+func ErrInvalidSignature() error {
+	return errInvalidSignature
+}
+
+func ErrInvalidSSHKey() error {
+	return errInvalidSSHKey
+}
+
+func ErrInvalidSSHSignature() error {
+	return errInvalidSSHSignature
+}
+
+func ErrInvalidValidity() error {
+	return errInvalidValidity
+}
+
+func ErrNamespaceMismatch() error {
+	return errNamespaceMismatch
+}
+
+func ErrNetworkAlreadyRegistered() error {
+	return errNetworkAlreadyRegistered
+}
+
+func ErrPayloadTypeMismatch() error {
+	return errPayloadTypeMismatch
+}
+
+func ErrPeerIDNotFound() error {
+	return errPeerIDNotFound
+}
+
 func ErrPublicKeyCannotBeNil() error {
 	return errPublicKeyCannotBeNil
 }
 
+func ErrRotationChainBroken() error {
+	return errRotationChainBroken
+}
+
 func ErrSignableCannotBeNil() error {
 	return errSignableCannotBeNil
 }
@@ -29,3 +205,31 @@ func ErrSignableCannotBeNil() error {
 func ErrSignatureCannotBeNil() error {
 	return errSignatureCannotBeNil
 }
+
+func ErrUnknownAlgo() error {
+	return errUnknownAlgo
+}
+
+func ErrUnknownHashAlgo() error {
+	return errUnknownHashAlgo
+}
+
+func ErrUnknownNetwork() error {
+	return errUnknownNetwork
+}
+
+func ErrUnknownTextEncoding() error {
+	return errUnknownTextEncoding
+}
+
+func ErrUnsupportedKeyType() error {
+	return errUnsupportedKeyType
+}
+
+func ErrUnsupportedScanType() error {
+	return errUnsupportedScanType
+}
+
+func ErrUntrustedIssuer() error {
+	return errUntrustedIssuer
+}
@@ -0,0 +1,156 @@
+// Copyright © 2020-2021 The EVEN Solutions Developers Team
+
+package crypto
+
+import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/sha256"
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/ssh"
+)
+
+const (
+	// randomartWidth is a width of the randomart field.
+	randomartWidth = 17
+
+	// randomartHeight is a height of the randomart field.
+	randomartHeight = 9
+
+	// randomartSymbols is a list of the randomart field symbols
+	// where the last two symbols mark start and end positions.
+	randomartSymbols = " .o+=*BOX@%&#/^SE"
+)
+
+// Fingerprint implements PublicKey.Fingerprint method of interface.
+func (c *publicKey) Fingerprint() (string, error) {
+	h224, err := c.Hash224()
+	if err != nil {
+		return "", err
+	}
+
+	return h224.Base58(), nil
+}
+
+// FingerprintSSH implements PublicKey.FingerprintSSH method of interface.
+func (c *publicKey) FingerprintSSH() (string, error) {
+	key, err := sshPublicKey(c)
+	if err != nil {
+		return "", err
+	}
+
+	return ssh.FingerprintSHA256(key), nil
+}
+
+// Randomart implements PublicKey.Randomart method of interface.
+func (c *publicKey) Randomart() (string, error) {
+	raw, err := c.Raw()
+	if err != nil {
+		return "", err
+	}
+
+	// SSH wire format is hashed to match OpenSSH randomart,
+	// raw bytes are hashed for the keys not supported by SSH.
+	digest, title := sha256.Sum256(raw), ""
+	if key, err := sshPublicKey(c); err == nil {
+		digest = sha256.Sum256(key.Marshal())
+	}
+
+	key, err := PublicKeyToStd(c)
+	if err != nil {
+		return "", err
+	}
+
+	switch ki := key.(type) {
+	case ed25519.PublicKey:
+		title = "ED25519 256"
+	case *ecdsa.PublicKey:
+		title = fmt.Sprintf("ECDSA %d", ki.Curve.Params().BitSize)
+		if c.Algo() == Secp256k1 {
+			title = fmt.Sprintf("SECP256K1 %d", ki.Curve.Params().BitSize)
+		}
+	case *rsa.PublicKey:
+		title = fmt.Sprintf("RSA %d", ki.N.BitLen())
+	}
+
+	return randomart(digest[:], title, "SHA256"), nil
+}
+
+// randomart draws the digest with the drunken bishop
+// algorithm compatible with OpenSSH visual host key.
+func randomart(digest []byte, title, footer string) string {
+	field := [randomartWidth][randomartHeight]int{}
+	x, y := randomartWidth/2, randomartHeight/2
+	top := len(randomartSymbols) - 1
+
+	for _, b := range digest {
+		for i := 0; i < 4; i++ {
+			if b&0x1 != 0 {
+				x++
+			} else {
+				x--
+			}
+			if b&0x2 != 0 {
+				y++
+			} else {
+				y--
+			}
+
+			x = clamp(x, randomartWidth-1)
+			y = clamp(y, randomartHeight-1)
+			if field[x][y] < top-2 {
+				field[x][y]++
+			}
+
+			b >>= 2
+		}
+	}
+
+	field[randomartWidth/2][randomartHeight/2] = top - 1
+	field[x][y] = top
+
+	sb := strings.Builder{}
+	randomartBorder(&sb, title)
+	for j := 0; j < randomartHeight; j++ {
+		sb.WriteByte('|')
+		for i := 0; i < randomartWidth; i++ {
+			sb.WriteByte(randomartSymbols[field[i][j]])
+		}
+		sb.WriteString("|\n")
+	}
+	randomartBorder(&sb, footer)
+
+	return strings.TrimSuffix(sb.String(), "\n")
+}
+
+// randomartBorder writes the field border with centered label.
+func randomartBorder(sb *strings.Builder, label string) {
+	if label != "" {
+		label = "[" + label + "]"
+		if len(label) > randomartWidth {
+			label = label[:randomartWidth]
+		}
+	}
+
+	pad := (randomartWidth - len(label)) / 2
+	sb.WriteByte('+')
+	sb.WriteString(strings.Repeat("-", pad))
+	sb.WriteString(label)
+	sb.WriteString(strings.Repeat("-", randomartWidth-pad-len(label)))
+	sb.WriteString("+\n")
+}
+
+// clamp limits the value to the range from zero to max.
+func clamp(val, max int) int {
+	if val < 0 {
+		return 0
+	}
+	if val > max {
+		return max
+	}
+
+	return val
+}
@@ -0,0 +1,211 @@
+// Copyright © 2020-2021 The EVEN Solutions Developers Team
+
+package crypto_test
+
+import (
+	"testing"
+
+	. "github.com/platsko/go-kit/crypto"
+)
+
+const (
+	// Keys and outputs are generated with "ssh-keygen -lv".
+	mockSSHKeyEd25519 = "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIPqYwbjkoT3R56nZ4yTOkuvnr9n6Cbj12yzOBXMVWx5f"
+	mockSSHKeyRSA     = "ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAABAQCVz7b26RWfA+ma9dL1yC/XG6Bm0SNl8t0+Sfg5cdTfLFYAQj+BvCPPfCs8" +
+		"DR9aNHJ7GqGqU4zZWEUwQn3B6EXjpQ5g8g0xLZj1ilOADoTdqLhQRXrPBHYDPHnkhkc1rk56h6r3/OjYqkYJTBQRpyGy1DxuKG8mlRKopeknEQgu" +
+		"Q0Fs/8UEO0pXzFzvEtT2yx0eLEFECWQrC09zvvOckTz4za/JD6sFR4Qn8dV6/GI75ssTgoq3k9/XUoucEzmb/OviBmb/dMkqWikB8M2kSoqg15CF" +
+		"BPRogPAWXSJz32hRlogycroUJp0XzMZOySgglh8VjGM69pQ9Svsv1NXi49KP"
+
+	mockRandomartEd25519 = "+--[ED25519 256]--+\n" +
+		"|     oo+ + **.   |\n" +
+		"|    . +.B ++o    |\n" +
+		"|     o = +  .    |\n" +
+		"|      = o...     |\n" +
+		"|       +S.=.     |\n" +
+		"|      . ++ ++    |\n" +
+		"|      .o+.E=.    |\n" +
+		"|       o =++oo   |\n" +
+		"|        . =B+    |\n" +
+		"+----[SHA256]-----+"
+
+	mockRandomartRSA = "+---[RSA 2048]----+\n" +
+		"| .             .+|\n" +
+		"|o  .       .    O|\n" +
+		"|... .       o  *+|\n" +
+		"|o.   o.    + o.+=|\n" +
+		"|.+   .ooS o...*++|\n" +
+		"|. E   o.o.o o.++ |\n" +
+		"|       ..+ + .o+o|\n" +
+		"|       .. . o.o.o|\n" +
+		"|      ..   ..... |\n" +
+		"+----[SHA256]-----+"
+)
+
+func mockSSHPublicKey(authorizedKey string) PublicKey {
+	pbKey, _, err := ParseAuthorizedKey([]byte(authorizedKey))
+	if err != nil {
+		panic(err)
+	}
+
+	return pbKey
+}
+
+func Benchmark_PublicKey_Randomart(b *testing.B) {
+	pbKey := mockSSHPublicKey(mockSSHKeyEd25519)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := pbKey.Randomart(); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func Test_PublicKey_Fingerprint(t *testing.T) {
+	t.Parallel()
+
+	_, pbKey := mockGenerateKeyPair(Ed25519)
+	h224, err := pbKey.Hash224()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := [2]struct {
+		name    string
+		pbKey   PublicKey
+		want    string
+		wantErr bool
+	}{
+		{
+			name:  "OK",
+			pbKey: pbKey,
+			want:  h224.Base58(),
+		},
+		{
+			name:    "ERR",
+			pbKey:   NewPublicKey(nil),
+			wantErr: true,
+		},
+	}
+
+	for idx := range tests {
+		test := tests[idx]
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := test.pbKey.Fingerprint()
+			if (err != nil) != test.wantErr {
+				t.Errorf("Fingerprint() error: %v | want: %v", err, test.wantErr)
+				return
+			}
+			if got != test.want {
+				t.Errorf("Fingerprint() got: %v | want: %v", got, test.want)
+			}
+		})
+	}
+}
+
+func Test_PublicKey_FingerprintSSH(t *testing.T) {
+	t.Parallel()
+
+	_, secp256k1 := mockGenerateKeyPair(Secp256k1)
+	tests := [4]struct {
+		name    string
+		pbKey   PublicKey
+		want    string
+		wantErr bool
+	}{
+		{
+			name:  "Ed25519_OK",
+			pbKey: mockSSHPublicKey(mockSSHKeyEd25519),
+			want:  "SHA256:W577UloGYI08yDS5sxdVTE6WhKjw7fYfa6aZIB9nkbw",
+		},
+		{
+			name:  "RSA_OK",
+			pbKey: mockSSHPublicKey(mockSSHKeyRSA),
+			want:  "SHA256:l038h8cZedlFfea6S0Ocf2OEK/kKHYrQ9moBAwq5D9o",
+		},
+		{
+			name:    "Secp256k1_ERR",
+			pbKey:   secp256k1,
+			wantErr: true,
+		},
+		{
+			name:    "nil_key_ERR",
+			pbKey:   NewPublicKey(nil),
+			wantErr: true,
+		},
+	}
+
+	for idx := range tests {
+		test := tests[idx]
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := test.pbKey.FingerprintSSH()
+			if (err != nil) != test.wantErr {
+				t.Errorf("FingerprintSSH() error: %v | want: %v", err, test.wantErr)
+				return
+			}
+			if got != test.want {
+				t.Errorf("FingerprintSSH() got: %v | want: %v", got, test.want)
+			}
+		})
+	}
+}
+
+func Test_PublicKey_Randomart(t *testing.T) {
+	t.Parallel()
+
+	tests := [3]struct {
+		name    string
+		pbKey   PublicKey
+		want    string
+		wantErr bool
+	}{
+		{
+			name:  "Ed25519_OK",
+			pbKey: mockSSHPublicKey(mockSSHKeyEd25519),
+			want:  mockRandomartEd25519,
+		},
+		{
+			name:  "RSA_OK",
+			pbKey: mockSSHPublicKey(mockSSHKeyRSA),
+			want:  mockRandomartRSA,
+		},
+		{
+			name:    "nil_key_ERR",
+			pbKey:   NewPublicKey(nil),
+			wantErr: true,
+		},
+	}
+
+	for idx := range tests {
+		test := tests[idx]
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := test.pbKey.Randomart()
+			if (err != nil) != test.wantErr {
+				t.Errorf("Randomart() error: %v | want: %v", err, test.wantErr)
+				return
+			}
+			if got != test.want {
+				t.Errorf("Randomart() got:\n%v\nwant:\n%v", got, test.want)
+			}
+		})
+	}
+}
+
+func Test_PublicKey_Randomart_Secp256k1(t *testing.T) {
+	t.Parallel()
+
+	_, pbKey := mockGenerateKeyPair(Secp256k1)
+	got, err := pbKey.Randomart()
+	if err != nil {
+		t.Errorf("Randomart() error: %v | want: %v", err, false)
+		return
+	}
+	if want := "+-[SECP256K1 256]-+"; got[:len(want)] != want {
+		t.Errorf("Randomart() got:\n%v\nwant title: %v", got, want)
+	}
+}
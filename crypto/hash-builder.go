@@ -0,0 +1,162 @@
+// Copyright © 2020-2021 The EVEN Solutions Developers Team
+
+package crypto
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"hash"
+	"io"
+)
+
+const (
+	// stringChunkSize is a size of the buffer used to write strings.
+	stringChunkSize = 512
+)
+
+type (
+	// Hash256Builder calculates Hash256 incrementally,
+	// it implements hash.Hash and io.Writer interfaces.
+	Hash256Builder struct {
+		h hash.Hash
+	}
+
+	// Hash224Builder calculates Hash224 incrementally,
+	// it implements hash.Hash and io.Writer interfaces.
+	// The result is SHA224 checksum over SHA256 checksum over written bytes.
+	Hash224Builder struct {
+		h hash.Hash
+	}
+)
+
+var (
+	// Make sure Hash256Builder implements hash.Hash interface.
+	_ hash.Hash = (*Hash256Builder)(nil)
+
+	// Make sure Hash256Builder implements io.StringWriter interface.
+	_ io.StringWriter = (*Hash256Builder)(nil)
+
+	// Make sure Hash224Builder implements hash.Hash interface.
+	_ hash.Hash = (*Hash224Builder)(nil)
+
+	// Make sure Hash224Builder implements io.StringWriter interface.
+	_ io.StringWriter = (*Hash224Builder)(nil)
+)
+
+// NewHash256Builder returns initialized Hash256Builder.
+func NewHash256Builder() *Hash256Builder {
+	return &Hash256Builder{h: sha256.New()}
+}
+
+// BlockSize implements hash.Hash.BlockSize method of interface.
+func (c *Hash256Builder) BlockSize() int {
+	return c.h.BlockSize()
+}
+
+// Hash256 returns calculated Hash256 over written bytes,
+// it does not change the underlying hash state.
+func (c *Hash256Builder) Hash256() (h256 Hash256) {
+	c.h.Sum(h256[:0])
+
+	return h256
+}
+
+// Reset implements hash.Hash.Reset method of interface.
+func (c *Hash256Builder) Reset() {
+	c.h.Reset()
+}
+
+// Size implements hash.Hash.Size method of interface.
+func (c *Hash256Builder) Size() int {
+	return Hash256Size
+}
+
+// Sum implements hash.Hash.Sum method of interface.
+func (c *Hash256Builder) Sum(b []byte) []byte {
+	return c.h.Sum(b)
+}
+
+// Write implements io.Writer interface, it never returns an error.
+func (c *Hash256Builder) Write(b []byte) (int, error) {
+	return c.h.Write(b)
+}
+
+// WriteString implements io.StringWriter interface, it never returns an error.
+func (c *Hash256Builder) WriteString(s string) (int, error) {
+	return writeString(c.h, s)
+}
+
+// NewHash224Builder returns initialized Hash224Builder.
+func NewHash224Builder() *Hash224Builder {
+	return &Hash224Builder{h: sha256.New()}
+}
+
+// BlockSize implements hash.Hash.BlockSize method of interface.
+func (c *Hash224Builder) BlockSize() int {
+	return c.h.BlockSize()
+}
+
+// Hash224 returns calculated Hash224 over written bytes,
+// it does not change the underlying hash state.
+func (c *Hash224Builder) Hash224() Hash224 {
+	h256 := Hash256{}
+	c.h.Sum(h256[:0])
+
+	return sha256.Sum224(h256[:])
+}
+
+// Reset implements hash.Hash.Reset method of interface.
+func (c *Hash224Builder) Reset() {
+	c.h.Reset()
+}
+
+// Size implements hash.Hash.Size method of interface.
+func (c *Hash224Builder) Size() int {
+	return Hash224Size
+}
+
+// Sum implements hash.Hash.Sum method of interface.
+func (c *Hash224Builder) Sum(b []byte) []byte {
+	h224 := c.Hash224()
+
+	return append(b, h224[:]...)
+}
+
+// Write implements io.Writer interface, it never returns an error.
+func (c *Hash224Builder) Write(b []byte) (int, error) {
+	return c.h.Write(b)
+}
+
+// WriteString implements io.StringWriter interface, it never returns an error.
+func (c *Hash224Builder) WriteString(s string) (int, error) {
+	return writeString(c.h, s)
+}
+
+// hashFields calculates Hash256 over uvarint length-prefixed fields,
+// so the boundaries of the fields are covered by the hash.
+func hashFields(fields ...[]byte) Hash256 {
+	b, size := NewHash256Builder(), [binary.MaxVarintLen64]byte{}
+	for _, field := range fields { // writes never return an error
+		b.Write(size[:binary.PutUvarint(size[:], uint64(len(field)))])
+		b.Write(field)
+	}
+
+	return b.Hash256()
+}
+
+// writeString writes the string to the hash
+// by chunks to avoid the whole string copying.
+func writeString(h hash.Hash, s string) (int, error) {
+	if sw, ok := h.(io.StringWriter); ok {
+		return sw.WriteString(s)
+	}
+
+	buf, size := [stringChunkSize]byte{}, len(s)
+	for len(s) > 0 {
+		n := copy(buf[:], s)
+		h.Write(buf[:n]) // never returns an error
+		s = s[n:]
+	}
+
+	return size, nil
+}
@@ -0,0 +1,102 @@
+// Copyright © 2020-2021 The EVEN Solutions Developers Team
+
+package crypto_test
+
+import (
+	"crypto/sha256"
+	"io"
+	"testing"
+
+	"github.com/platsko/go-kit/bytes"
+	. "github.com/platsko/go-kit/crypto"
+	"github.com/platsko/go-kit/strings"
+)
+
+func Benchmark_Hash256Builder_Write(b *testing.B) {
+	blob := bytes.RandBytes(1024)
+	builder := NewHash256Builder()
+	b.SetBytes(int64(len(blob)))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = builder.Write(blob)
+	}
+}
+
+func Benchmark_Hash224Builder_Write(b *testing.B) {
+	blob := bytes.RandBytes(1024)
+	builder := NewHash224Builder()
+	b.SetBytes(int64(len(blob)))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = builder.Write(blob)
+	}
+}
+
+func Test_Hash256Builder(t *testing.T) {
+	t.Parallel()
+
+	b1 := bytes.RandBytes(128)
+	s2 := strings.RandString(1024 + 7)
+	want := sha256.Sum256(append(append([]byte{}, b1...), s2...))
+
+	builder := NewHash256Builder()
+	if _, err := builder.Write(b1); err != nil {
+		t.Fatal(err)
+	}
+	if n, err := io.WriteString(builder, s2); err != nil || n != len(s2) {
+		t.Fatalf("WriteString() got: %v, error: %v | want: %v", n, err, len(s2))
+	}
+
+	if got := builder.Hash256(); got != want {
+		t.Errorf("Hash256() got: %x | want: %x", got, want)
+	}
+	if got := builder.Hash256(); got != want {
+		t.Errorf("Hash256() repeated got: %x | want: %x", got, want)
+	}
+	if got := builder.Sum([]byte{0x01}); string(got) != string(append([]byte{0x01}, want[:]...)) {
+		t.Errorf("Sum() got: %x | want: %x", got, want)
+	}
+	if builder.Size() != Hash256Size || builder.BlockSize() != sha256.BlockSize {
+		t.Errorf("Size() got: %v | want: %v", builder.Size(), Hash256Size)
+	}
+
+	builder.Reset()
+	if got, want := builder.Hash256(), NewHash256(); got != want {
+		t.Errorf("Reset() got: %x | want: %x", got, want)
+	}
+}
+
+func Test_Hash224Builder(t *testing.T) {
+	t.Parallel()
+
+	b1 := bytes.RandBytes(128)
+	s2 := strings.RandString(1024 + 7)
+	h256 := sha256.Sum256(append(append([]byte{}, b1...), s2...))
+	want := sha256.Sum224(h256[:])
+
+	builder := NewHash224Builder()
+	if _, err := builder.Write(b1); err != nil {
+		t.Fatal(err)
+	}
+	if n, err := io.WriteString(builder, s2); err != nil || n != len(s2) {
+		t.Fatalf("WriteString() got: %v, error: %v | want: %v", n, err, len(s2))
+	}
+
+	if got := builder.Hash224(); got != want {
+		t.Errorf("Hash224() got: %x | want: %x", got, want)
+	}
+	if got := builder.Sum(nil); string(got) != string(want[:]) {
+		t.Errorf("Sum() got: %x | want: %x", got, want)
+	}
+	if builder.Size() != Hash224Size || builder.BlockSize() != sha256.BlockSize {
+		t.Errorf("Size() got: %v | want: %v", builder.Size(), Hash224Size)
+	}
+
+	builder.Reset()
+	if got, want := builder.Hash224(), NewHash224(); got != want {
+		t.Errorf("Reset() got: %x | want: %x", got, want)
+	}
+	if got, want := NewHash224(b1, []byte(s2)), StrToHash224(string(b1), s2); got != want {
+		t.Errorf("StrToHash224() got: %x | want: %x", got, want)
+	}
+}
@@ -0,0 +1,97 @@
+// Copyright © 2020-2021 The EVEN Solutions Developers Team
+
+package crypto
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	"github.com/platsko/go-kit/base58"
+)
+
+const (
+	// hashShortSize is a size in bytes of the hash short form.
+	hashShortSize = 4
+)
+
+// parseHash decodes hex or base58 string into the hash bytes,
+// the string is treated as hex if its length matches hex encoded hash.
+func parseHash(dst []byte, s string) error {
+	if len(s) == hex.EncodedLen(len(dst)) {
+		if blob, err := hex.DecodeString(s); err == nil {
+			copy(dst, blob)
+			return nil
+		}
+	}
+
+	blob, err := base58.DecodeString(s)
+	if err != nil {
+		return ErrInvalidHash()
+	}
+	if len(blob) != len(dst) {
+		return ErrInvalidHashLength()
+	}
+
+	copy(dst, blob)
+
+	return nil
+}
+
+// unmarshalHash copies binary blob into the hash bytes.
+func unmarshalHash(dst, blob []byte) error {
+	if len(blob) != len(dst) {
+		return ErrInvalidHashLength()
+	}
+
+	copy(dst, blob)
+
+	return nil
+}
+
+// scanHash implements sql.Scanner for the hash bytes,
+// raw bytes, hex and base58 strings are accepted.
+func scanHash(dst []byte, src interface{}) error {
+	switch val := src.(type) {
+	case nil:
+		for i := range dst {
+			dst[i] = 0
+		}
+		return nil
+	case []byte:
+		if len(val) == len(dst) {
+			copy(dst, val)
+			return nil
+		}
+		return parseHash(dst, string(val))
+	case string:
+		return parseHash(dst, val)
+	}
+
+	return ErrUnsupportedScanType()
+}
+
+// formatHash implements fmt.Formatter for the hash bytes:
+// %s prints short hex form, %v, %x and %X print full hex form,
+// %q prints quoted full hex form and %#v prints Go syntax.
+func formatHash(f fmt.State, verb rune, b []byte) {
+	switch verb {
+	case 's':
+		fmt.Fprintf(f, "%x", b[:hashShortSize])
+	case 'v':
+		if f.Flag('#') {
+			fmt.Fprintf(f, "%#v", b)
+			return
+		}
+		fmt.Fprintf(f, "%x", b)
+	case 'x', 'X':
+		format := "%" + string(verb)
+		if f.Flag('#') {
+			format = "%#" + string(verb)
+		}
+		fmt.Fprintf(f, format, b)
+	case 'q':
+		fmt.Fprintf(f, "%q", hex.EncodeToString(b))
+	default:
+		fmt.Fprintf(f, "%%!%c(%T=%x)", verb, b, b)
+	}
+}
@@ -0,0 +1,322 @@
+// Copyright © 2020-2021 The EVEN Solutions Developers Team
+
+package crypto_test
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/platsko/go-kit/base58"
+	. "github.com/platsko/go-kit/crypto"
+	"github.com/platsko/go-kit/errors"
+)
+
+var (
+	// Make sure Hash256 implements encoding interfaces.
+	_ encoding.BinaryMarshaler   = Hash256{}
+	_ encoding.TextMarshaler     = Hash256{}
+	_ json.Marshaler             = Hash256{}
+	_ fmt.Formatter              = Hash256{}
+	_ driver.Valuer              = Hash256{}
+	_ encoding.BinaryUnmarshaler = (*Hash256)(nil)
+	_ encoding.TextUnmarshaler   = (*Hash256)(nil)
+	_ json.Unmarshaler           = (*Hash256)(nil)
+	_ sql.Scanner                = (*Hash256)(nil)
+
+	// Make sure Hash224 implements encoding interfaces.
+	_ encoding.BinaryMarshaler   = Hash224{}
+	_ encoding.TextMarshaler     = Hash224{}
+	_ json.Marshaler             = Hash224{}
+	_ fmt.Formatter              = Hash224{}
+	_ driver.Valuer              = Hash224{}
+	_ encoding.BinaryUnmarshaler = (*Hash224)(nil)
+	_ encoding.TextUnmarshaler   = (*Hash224)(nil)
+	_ json.Unmarshaler           = (*Hash224)(nil)
+	_ sql.Scanner                = (*Hash224)(nil)
+)
+
+func Benchmark_ParseHash256(b *testing.B) {
+	str := h256.Encode()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := ParseHash256(str); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func Test_ParseHash256(t *testing.T) {
+	t.Parallel()
+
+	tests := [5]struct {
+		name    string
+		str     string
+		want    Hash256
+		wantErr error
+	}{
+		{
+			name: "hex_OK",
+			str:  h256.Encode(),
+			want: h256,
+		},
+		{
+			name: "base58_OK",
+			str:  h256.Base58(),
+			want: h256,
+		},
+		{
+			name:    "invalid_length_ERR",
+			str:     base58.EncodeToString(h256[1:]),
+			wantErr: ErrInvalidHashLength(),
+		},
+		{
+			name:    "invalid_hex_ERR",
+			str:     "l" + h256.Encode()[1:], // invalid in hex and base58
+			wantErr: ErrInvalidHash(),
+		},
+		{
+			name:    "invalid_base58_ERR",
+			str:     "0OIl",
+			wantErr: ErrInvalidHash(),
+		},
+	}
+
+	for idx := range tests {
+		test := tests[idx]
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := ParseHash256(test.str)
+			if !errors.Is(err, test.wantErr) {
+				t.Errorf("ParseHash256() error: %v | want: %v", err, test.wantErr)
+				return
+			}
+			if got != test.want {
+				t.Errorf("ParseHash256() got: %x | want: %x", got, test.want)
+			}
+		})
+	}
+}
+
+func Test_ParseHash224(t *testing.T) {
+	t.Parallel()
+
+	tests := [3]struct {
+		name    string
+		str     string
+		want    Hash224
+		wantErr error
+	}{
+		{
+			name: "hex_OK",
+			str:  h224.Encode(),
+			want: h224,
+		},
+		{
+			name: "base58_OK",
+			str:  h224.Base58(),
+			want: h224,
+		},
+		{
+			name:    "invalid_length_ERR",
+			str:     h256.Base58(),
+			wantErr: ErrInvalidHashLength(),
+		},
+	}
+
+	for idx := range tests {
+		test := tests[idx]
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := ParseHash224(test.str)
+			if !errors.Is(err, test.wantErr) {
+				t.Errorf("ParseHash224() error: %v | want: %v", err, test.wantErr)
+				return
+			}
+			if got != test.want {
+				t.Errorf("ParseHash224() got: %x | want: %x", got, test.want)
+			}
+		})
+	}
+}
+
+func Test_Hash256_Format(t *testing.T) {
+	t.Parallel()
+
+	hexStr := h256.Encode()
+	tests := [6]struct {
+		name   string
+		format string
+		want   string
+	}{
+		{name: "s", format: "%s", want: hexStr[:8]},
+		{name: "v", format: "%v", want: hexStr},
+		{name: "x", format: "%x", want: hexStr},
+		{name: "X", format: "%X", want: fmt.Sprintf("%X", h256[:])},
+		{name: "q", format: "%q", want: `"` + hexStr + `"`},
+		{name: "#v", format: "%#v", want: fmt.Sprintf("%#v", h256[:])},
+	}
+
+	for idx := range tests {
+		test := tests[idx]
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := fmt.Sprintf(test.format, h256); got != test.want {
+				t.Errorf("Format() got: %v | want: %v", got, test.want)
+			}
+		})
+	}
+}
+
+func Test_Hash256_MarshalBinary(t *testing.T) {
+	t.Parallel()
+
+	blob, err := h256.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got := Hash256{}
+	if err = got.UnmarshalBinary(blob); err != nil || got != h256 {
+		t.Errorf("UnmarshalBinary() got: %x, error: %v | want: %x", got, err, h256)
+	}
+	if err = got.UnmarshalBinary(blob[1:]); !errors.Is(err, ErrInvalidHashLength()) {
+		t.Errorf("UnmarshalBinary() error: %v | want: %v", err, ErrInvalidHashLength())
+	}
+}
+
+func Test_Hash224_MarshalBinary(t *testing.T) {
+	t.Parallel()
+
+	blob, err := h224.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got := Hash224{}
+	if err = got.UnmarshalBinary(blob); err != nil || got != h224 {
+		t.Errorf("UnmarshalBinary() got: %x, error: %v | want: %x", got, err, h224)
+	}
+}
+
+func Test_Hash256_MarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	type wrapper struct {
+		Hash Hash256 `json:"hash"`
+	}
+
+	blob, err := json.Marshal(wrapper{Hash: h256})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := `{"hash":"` + h256.Encode() + `"}`; string(blob) != want {
+		t.Errorf("MarshalJSON() got: %s | want: %v", blob, want)
+	}
+
+	got := wrapper{}
+	if err = json.Unmarshal(blob, &got); err != nil || got.Hash != h256 {
+		t.Errorf("UnmarshalJSON() got: %x, error: %v | want: %x", got.Hash, err, h256)
+	}
+	if err = json.Unmarshal([]byte(`{"hash":1}`), &got); err == nil {
+		t.Errorf("UnmarshalJSON() error: %v | want: %v", err, true)
+	}
+}
+
+func Test_Hash224_MarshalText(t *testing.T) {
+	t.Parallel()
+
+	text, err := h224.MarshalText()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got := Hash224{}
+	if err = got.UnmarshalText(text); err != nil || got != h224 {
+		t.Errorf("UnmarshalText() got: %x, error: %v | want: %x", got, err, h224)
+	}
+
+	blob, err := h224.MarshalJSON()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = got.UnmarshalJSON(blob); err != nil || got != h224 {
+		t.Errorf("UnmarshalJSON() got: %x, error: %v | want: %x", got, err, h224)
+	}
+}
+
+func Test_Hash256_Scan(t *testing.T) {
+	t.Parallel()
+
+	value, err := h256.Value()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := [5]struct {
+		name    string
+		src     interface{}
+		want    Hash256
+		wantErr error
+	}{
+		{
+			name: "raw_OK",
+			src:  value,
+			want: h256,
+		},
+		{
+			name: "hex_bytes_OK",
+			src:  []byte(h256.Encode()),
+			want: h256,
+		},
+		{
+			name: "string_OK",
+			src:  h256.Base58(),
+			want: h256,
+		},
+		{
+			name: "nil_OK",
+		},
+		{
+			name:    "unsupported_type_ERR",
+			src:     1,
+			wantErr: ErrUnsupportedScanType(),
+		},
+	}
+
+	for idx := range tests {
+		test := tests[idx]
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := h256
+			if err := got.Scan(test.src); !errors.Is(err, test.wantErr) {
+				t.Errorf("Scan() error: %v | want: %v", err, test.wantErr)
+				return
+			}
+			if test.wantErr == nil && got != test.want {
+				t.Errorf("Scan() got: %x | want: %x", got, test.want)
+			}
+		})
+	}
+}
+
+func Test_Hash224_Scan(t *testing.T) {
+	t.Parallel()
+
+	value, err := h224.Value()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got := Hash224{}
+	if err = got.Scan(value); err != nil || got != h224 {
+		t.Errorf("Scan() got: %x, error: %v | want: %x", got, err, h224)
+	}
+}
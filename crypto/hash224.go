@@ -4,10 +4,15 @@ package crypto
 
 import (
 	"crypto/sha256"
+	"database/sql/driver"
 	"encoding/hex"
+	"fmt"
 	"math/bits"
 
+	json "github.com/json-iterator/go"
+
 	"github.com/platsko/go-kit/base58"
+	"github.com/platsko/go-kit/bech32"
 )
 
 const (
@@ -22,42 +27,71 @@ type (
 
 // NewHash224 makes initialized Hash224.
 // Initial hash calculates SHA224 checksum over SHA256 checksum over specified bytes.
-func NewHash224(data ...[]byte) (h224 Hash224) {
-	size := 0
-	for _, d := range data {
-		size += len(d)
-	}
-
-	buf := make([]byte, 0, size)
+func NewHash224(data ...[]byte) Hash224 {
+	b := NewHash224Builder()
 	for _, blob := range data {
-		buf = append(buf, blob...)
+		b.Write(blob) // never returns an error
 	}
 
-	h256 := sha256.Sum256(buf)
-	h224 = sha256.Sum224(h256[:])
-
-	return h224
+	return b.Hash224()
 }
 
 // StrToHash224 makes initialized Hash224.
 // Initial hash calculates SHA224 checksum SHA256 checksum over specified strings.
-func StrToHash224(str ...string) (h224 Hash224) {
-	size := 0
+func StrToHash224(str ...string) Hash224 {
+	b := NewHash224Builder()
 	for _, s := range str {
-		size += len(s)
+		b.WriteString(s) // never returns an error
 	}
 
-	idx, buf := 0, make([]byte, size)
-	for _, s := range str {
-		for i, l := 0, len(s); i < l; i, idx = i+1, idx+1 {
-			buf[idx] = s[i]
-		}
+	return b.Hash224()
+}
+
+// ParseHash224 decodes hex or base58 string to Hash224.
+func ParseHash224(s string) (Hash224, error) {
+	h224 := Hash224{}
+	if err := parseHash(h224[:], s); err != nil {
+		return Hash224{}, err
+	}
+
+	return h224, nil
+}
+
+// ParseHash224Bech32 decodes Bech32m string to Hash224
+// and returns it with the human-readable part.
+func ParseHash224Bech32(s string) (Hash224, string, error) {
+	hrp, blob, variant, err := bech32.DecodeBytes(s)
+	if err != nil {
+		return Hash224{}, "", err
 	}
 
-	h256 := sha256.Sum256(buf)
-	h224 = sha256.Sum224(h256[:])
+	if variant != bech32.Bech32m {
+		return Hash224{}, "", ErrInvalidHash()
+	}
 
-	return h224
+	if len(blob) != Hash224Size {
+		return Hash224{}, "", ErrInvalidHashLength()
+	}
+
+	h224 := Hash224{}
+	copy(h224[:], blob)
+
+	return h224, hrp, nil
+}
+
+// ParseHash224Text decodes the string to Hash224 with the text encoding.
+func ParseHash224Text(enc TextEncoding, s string) (Hash224, error) {
+	h224 := Hash224{}
+	if err := decodeTextHash(h224[:], enc, s); err != nil {
+		return Hash224{}, err
+	}
+
+	return h224, nil
+}
+
+// Base32 returns Base32 encoded string over hashed bytes.
+func (c Hash224) Base32() string {
+	return encodeTextHash(Base32Encoding, c[:])
 }
 
 // Base58 returns Base58 encoded string over hashed bytes.
@@ -65,6 +99,17 @@ func (c Hash224) Base58() string {
 	return base58.EncodeToString(c[:])
 }
 
+// Base64 returns Base64 encoded string over hashed bytes.
+func (c Hash224) Base64() string {
+	return encodeTextHash(Base64Encoding, c[:])
+}
+
+// Bech32 returns Bech32m encoded string over hashed bytes
+// with the human-readable part.
+func (c Hash224) Bech32(hrp string) (string, error) {
+	return bech32.EncodeBytes(hrp, c[:], bech32.Bech32m)
+}
+
 // Empty returns true if the hash is zeroed.
 func (c Hash224) Empty() bool {
 	for _, b := range c {
@@ -91,7 +136,75 @@ func (c Hash224) Hamming(v224 [Hash224Size]byte) (dist int) {
 	return dist
 }
 
-// String implements stringer interface.
+// Hex returns hex encoded string over hashed bytes.
+func (c Hash224) Hex() string {
+	return c.Encode()
+}
+
+// String implements stringer interface,
+// the hash is hex encoded the same way as %v verb formats it.
 func (c Hash224) String() string {
-	return string(c[:])
+	return c.Hex()
+}
+
+// Text returns encoded string over hashed bytes with the text encoding.
+func (c Hash224) Text(enc TextEncoding) (string, error) {
+	return enc.EncodeToString(c[:])
+}
+
+// Format implements fmt.Formatter interface:
+// %s prints short hex form, %v, %x and %X print full hex form,
+// %q prints quoted full hex form and %#v prints Go syntax.
+func (c Hash224) Format(f fmt.State, verb rune) {
+	formatHash(f, verb, c[:])
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler interface.
+func (c Hash224) MarshalBinary() ([]byte, error) {
+	return c[:], nil
+}
+
+// MarshalJSON implements json.Marshaler interface,
+// the hash is encoded as hex string.
+func (c Hash224) MarshalJSON() ([]byte, error) {
+	return []byte(`"` + c.Encode() + `"`), nil
+}
+
+// MarshalText implements encoding.TextMarshaler interface,
+// the hash is encoded as hex string.
+func (c Hash224) MarshalText() ([]byte, error) {
+	return []byte(c.Encode()), nil
+}
+
+// Scan implements sql.Scanner interface.
+func (c *Hash224) Scan(src interface{}) error {
+	return scanHash(c[:], src)
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler interface.
+func (c *Hash224) UnmarshalBinary(blob []byte) error {
+	return unmarshalHash(c[:], blob)
+}
+
+// UnmarshalJSON implements json.Unmarshaler interface,
+// hex and base58 strings are accepted.
+func (c *Hash224) UnmarshalJSON(blob []byte) error {
+	s := ""
+	if err := json.Unmarshal(blob, &s); err != nil {
+		return err
+	}
+
+	return parseHash(c[:], s)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler interface,
+// hex and base58 strings are accepted.
+func (c *Hash224) UnmarshalText(text []byte) error {
+	return parseHash(c[:], string(text))
+}
+
+// Value implements driver.Valuer interface,
+// the hash is stored as raw bytes.
+func (c Hash224) Value() (driver.Value, error) {
+	return c[:], nil
 }
@@ -5,12 +5,15 @@ package crypto_test
 import (
 	"crypto/sha256"
 	"encoding/hex"
+	"fmt"
 	"reflect"
 	"testing"
 
 	"github.com/platsko/go-kit/base58"
+	"github.com/platsko/go-kit/bech32"
 	"github.com/platsko/go-kit/bytes"
 	. "github.com/platsko/go-kit/crypto"
+	"github.com/platsko/go-kit/errors"
 	"github.com/platsko/go-kit/strings"
 )
 
@@ -281,7 +284,7 @@ func Test_Hash224_String(t *testing.T) {
 		{
 			name: "OK",
 			h224: h224,
-			want: string(h224[:]),
+			want: h224.Hex(),
 		},
 	}
 
@@ -293,6 +296,100 @@ func Test_Hash224_String(t *testing.T) {
 			if got := test.h224.String(); got != test.want {
 				t.Errorf("String() got: %v | want: %v", got, test.want)
 			}
+			if got := fmt.Sprintf("%v", test.h224); got != test.want {
+				t.Errorf("Sprintf() got: %v | want: %v", got, test.want)
+			}
+		})
+	}
+}
+
+func Test_Hash224_Bech32(t *testing.T) {
+	t.Parallel()
+
+	tests := [3]struct {
+		name    string
+		hash    Hash224
+		hrp     string
+		wantErr bool
+	}{
+		{
+			name: "OK",
+			hash: h224,
+			hrp:  "kit",
+		},
+		{
+			name: "zero_OK",
+			hrp:  "kit",
+		},
+		{
+			name:    "empty_hrp_ERR",
+			hash:    h224,
+			wantErr: true,
+		},
+	}
+
+	for idx := range tests {
+		test := tests[idx]
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			str, err := test.hash.Bech32(test.hrp)
+			if (err != nil) != test.wantErr {
+				t.Errorf("Bech32() error: %v | want: %v", err, test.wantErr)
+				return
+			}
+			if test.wantErr {
+				return
+			}
+
+			got, hrp, err := ParseHash224Bech32(str)
+			if err != nil {
+				t.Errorf("ParseHash224Bech32() error: %v | want: %v", err, nil)
+				return
+			}
+			if got != test.hash || hrp != test.hrp {
+				t.Errorf("ParseHash224Bech32() got: %v %v | want: %v %v", got, hrp, test.hash, test.hrp)
+			}
+		})
+	}
+}
+
+func Test_ParseHash224Bech32(t *testing.T) {
+	t.Parallel()
+
+	bech32Str, _ := bech32.EncodeBytes("kit", h224[:], bech32.Bech32)
+	shortStr, _ := bech32.EncodeBytes("kit", h224[1:], bech32.Bech32m)
+
+	tests := [3]struct {
+		name    string
+		str     string
+		wantErr error
+	}{
+		{
+			name:    "Bech32_variant_ERR",
+			str:     bech32Str,
+			wantErr: ErrInvalidHash(),
+		},
+		{
+			name:    "short_ERR",
+			str:     shortStr,
+			wantErr: ErrInvalidHashLength(),
+		},
+		{
+			name:    "checksum_ERR",
+			str:     "kit1qqqqqqqq",
+			wantErr: bech32.ErrInvalidChecksum(),
+		},
+	}
+
+	for idx := range tests {
+		test := tests[idx]
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			if _, _, err := ParseHash224Bech32(test.str); !errors.Is(err, test.wantErr) {
+				t.Errorf("ParseHash224Bech32() error: %v | want: %v", err, test.wantErr)
+			}
 		})
 	}
 }
@@ -4,15 +4,22 @@ package crypto
 
 import (
 	"crypto/sha256"
+	"database/sql/driver"
 	"encoding/hex"
+	"fmt"
 	"math/bits"
 
+	json "github.com/json-iterator/go"
+
 	"github.com/platsko/go-kit/base58"
 )
 
 const (
 	// Hash256Size defines size in bytes.
 	Hash256Size = sha256.Size
+
+	// base58Hash256Size is the maximum size of base58 encoded Hash256.
+	base58Hash256Size = Hash256Size*138/100 + 1 // nolint: gomnd
 )
 
 type (
@@ -22,43 +29,61 @@ type (
 
 // NewHash256 makes initialized Hash256.
 // Initial hash calculates SHA256 checksum over specified bytes.
-func NewHash256(data ...[]byte) (h256 Hash256) {
-	size := 0
-	for _, d := range data {
-		size += len(d)
-	}
-
-	buf := make([]byte, 0, size)
+func NewHash256(data ...[]byte) Hash256 {
+	b := NewHash256Builder()
 	for _, blob := range data {
-		buf = append(buf, blob...)
+		b.Write(blob) // never returns an error
 	}
-	h256 = sha256.Sum256(buf)
 
-	return h256
+	return b.Hash256()
 }
 
 // StrToHash256 makes initialized Hash256.
 // Initial hash calculates SHA256 checksum over specified strings.
-func StrToHash256(str ...string) (h256 Hash256) {
-	size := 0
+func StrToHash256(str ...string) Hash256 {
+	b := NewHash256Builder()
 	for _, s := range str {
-		size += len(s)
+		b.WriteString(s) // never returns an error
 	}
 
-	idx, buf := 0, make([]byte, size)
-	for _, s := range str {
-		for i, l := 0, len(s); i < l; i, idx = i+1, idx+1 {
-			buf[idx] = s[i]
-		}
+	return b.Hash256()
+}
+
+// ParseHash256 decodes hex or base58 string to Hash256.
+func ParseHash256(s string) (Hash256, error) {
+	h256 := Hash256{}
+	if err := parseHash(h256[:], s); err != nil {
+		return Hash256{}, err
 	}
-	h256 = sha256.Sum256(buf)
 
-	return h256
+	return h256, nil
+}
+
+// ParseHash256Text decodes the string to Hash256 with the text encoding.
+func ParseHash256Text(enc TextEncoding, s string) (Hash256, error) {
+	h256 := Hash256{}
+	if err := decodeTextHash(h256[:], enc, s); err != nil {
+		return Hash256{}, err
+	}
+
+	return h256, nil
+}
+
+// Base32 returns Base32 encoded string over hashed bytes.
+func (c Hash256) Base32() string {
+	return encodeTextHash(Base32Encoding, c[:])
 }
 
 // Base58 returns Base58 encoded string over hashed bytes.
 func (c Hash256) Base58() string {
-	return base58.EncodeToString(c[:])
+	buf := [base58Hash256Size]byte{}
+
+	return string(base58.AppendEncode32(buf[:0], (*[Hash256Size]byte)(&c)))
+}
+
+// Base64 returns Base64 encoded string over hashed bytes.
+func (c Hash256) Base64() string {
+	return encodeTextHash(Base64Encoding, c[:])
 }
 
 // Empty returns true if the hash is zeroed.
@@ -87,7 +112,75 @@ func (c Hash256) Hamming(v256 [Hash256Size]byte) (dist int) {
 	return dist
 }
 
-// String implements stringer interface.
+// Hex returns hex encoded string over hashed bytes.
+func (c Hash256) Hex() string {
+	return c.Encode()
+}
+
+// String implements stringer interface,
+// the hash is hex encoded the same way as %v verb formats it.
 func (c Hash256) String() string {
-	return string(c[:])
+	return c.Hex()
+}
+
+// Text returns encoded string over hashed bytes with the text encoding.
+func (c Hash256) Text(enc TextEncoding) (string, error) {
+	return enc.EncodeToString(c[:])
+}
+
+// Format implements fmt.Formatter interface:
+// %s prints short hex form, %v, %x and %X print full hex form,
+// %q prints quoted full hex form and %#v prints Go syntax.
+func (c Hash256) Format(f fmt.State, verb rune) {
+	formatHash(f, verb, c[:])
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler interface.
+func (c Hash256) MarshalBinary() ([]byte, error) {
+	return c[:], nil
+}
+
+// MarshalJSON implements json.Marshaler interface,
+// the hash is encoded as hex string.
+func (c Hash256) MarshalJSON() ([]byte, error) {
+	return []byte(`"` + c.Encode() + `"`), nil
+}
+
+// MarshalText implements encoding.TextMarshaler interface,
+// the hash is encoded as hex string.
+func (c Hash256) MarshalText() ([]byte, error) {
+	return []byte(c.Encode()), nil
+}
+
+// Scan implements sql.Scanner interface.
+func (c *Hash256) Scan(src interface{}) error {
+	return scanHash(c[:], src)
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler interface.
+func (c *Hash256) UnmarshalBinary(blob []byte) error {
+	return unmarshalHash(c[:], blob)
+}
+
+// UnmarshalJSON implements json.Unmarshaler interface,
+// hex and base58 strings are accepted.
+func (c *Hash256) UnmarshalJSON(blob []byte) error {
+	s := ""
+	if err := json.Unmarshal(blob, &s); err != nil {
+		return err
+	}
+
+	return parseHash(c[:], s)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler interface,
+// hex and base58 strings are accepted.
+func (c *Hash256) UnmarshalText(text []byte) error {
+	return parseHash(c[:], string(text))
+}
+
+// Value implements driver.Valuer interface,
+// the hash is stored as raw bytes.
+func (c Hash256) Value() (driver.Value, error) {
+	return c[:], nil
 }
@@ -5,6 +5,7 @@ package crypto_test
 import (
 	"crypto/sha256"
 	"encoding/hex"
+	"fmt"
 	"reflect"
 	"testing"
 
@@ -282,7 +283,7 @@ func Test_Hash256_String(t *testing.T) {
 		{
 			name: "Test_Hash256_String_OK",
 			h256: h256,
-			want: string(h256[:]),
+			want: h256.Hex(),
 		},
 	}
 
@@ -294,6 +295,9 @@ func Test_Hash256_String(t *testing.T) {
 			if got := test.h256.String(); got != test.want {
 				t.Errorf("String() got: %v | want: %v", got, test.want)
 			}
+			if got := fmt.Sprintf("%v", test.h256); got != test.want {
+				t.Errorf("Sprintf() got: %v | want: %v", got, test.want)
+			}
 		})
 	}
 }
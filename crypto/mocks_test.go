@@ -7,6 +7,7 @@ import (
 
 	"github.com/platsko/go-kit/bytes"
 	. "github.com/platsko/go-kit/crypto"
+	"github.com/platsko/go-kit/errors"
 )
 
 func mockCryptoKeyPair(algo Algo) (cc.PrivKey, cc.PubKey) {
@@ -57,3 +58,33 @@ func mockSignature(algo Algo) (Signature, PrivateKey) {
 
 	return signable.Sign, prKey
 }
+
+type (
+	// signableKeyStub implements Signable interface
+	// with hash func that covers the public key.
+	signableKeyStub struct {
+		SignableStub
+	}
+)
+
+// Hash implements Hasher.Hash method of interface.
+func (c *signableKeyStub) Hash() (Hash256, error) {
+	if c.Blob == nil {
+		return Hash256{}, errors.ErrNilPointerValue()
+	}
+
+	if c.PbKey == nil {
+		return NewHash256(c.Blob), nil
+	}
+
+	raw, err := c.PbKey.Raw()
+	if err != nil {
+		return Hash256{}, err
+	}
+
+	return NewHash256(c.Blob, raw), nil
+}
+
+func mockSignableKey(size int) *signableKeyStub {
+	return &signableKeyStub{SignableStub: SignableStub{Blob: bytes.RandBytes(size)}}
+}
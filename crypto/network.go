@@ -0,0 +1,105 @@
+// Copyright © 2020-2021 The EVEN Solutions Developers Team
+
+package crypto
+
+import (
+	"sort"
+	"sync"
+)
+
+const (
+	// MainNet is a name of the main network.
+	MainNet = "mainnet"
+
+	// MainNetVersion is an address version byte of the main network.
+	MainNetVersion byte = 0x00
+
+	// TestNet is a name of the test network.
+	TestNet = "testnet"
+
+	// TestNetVersion is an address version byte of the test network.
+	TestNetVersion byte = 0x6f
+)
+
+type (
+	// Network describes a network with its address version byte.
+	Network struct {
+		Name    string
+		Version byte
+	}
+)
+
+var (
+	// networks holds registered networks by address version byte.
+	networks = map[byte]Network{
+		MainNetVersion: {Name: MainNet, Version: MainNetVersion},
+		TestNetVersion: {Name: TestNet, Version: TestNetVersion},
+	}
+
+	// networksMutex guards networks registry.
+	networksMutex sync.RWMutex
+)
+
+// RegisterNetwork adds the network to the registry,
+// both the name and the version byte have to be unique.
+func RegisterNetwork(name string, ver byte) error {
+	if name == "" {
+		return ErrInvalidNetwork()
+	}
+
+	networksMutex.Lock()
+	defer networksMutex.Unlock()
+
+	if _, ok := networks[ver]; ok {
+		return ErrNetworkAlreadyRegistered()
+	}
+	for _, network := range networks {
+		if network.Name == name {
+			return ErrNetworkAlreadyRegistered()
+		}
+	}
+
+	networks[ver] = Network{Name: name, Version: ver}
+
+	return nil
+}
+
+// GetNetwork returns registered network by its name.
+func GetNetwork(name string) (Network, bool) {
+	networksMutex.RLock()
+	defer networksMutex.RUnlock()
+
+	for _, network := range networks {
+		if network.Name == name {
+			return network, true
+		}
+	}
+
+	return Network{}, false
+}
+
+// GetNetworkByVersion returns registered network by its address version byte.
+func GetNetworkByVersion(ver byte) (Network, bool) {
+	networksMutex.RLock()
+	defer networksMutex.RUnlock()
+
+	network, ok := networks[ver]
+
+	return network, ok
+}
+
+// GetNetworks returns a list of registered networks sorted by version byte.
+func GetNetworks() []Network {
+	networksMutex.RLock()
+	list := make([]Network, 0, len(networks))
+	for _, network := range networks {
+		list = append(list, network)
+	}
+	networksMutex.RUnlock()
+
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].Version < list[j].Version
+	})
+
+	return list
+}
@@ -0,0 +1,84 @@
+// Copyright © 2020-2021 The EVEN Solutions Developers Team
+
+package crypto_test
+
+import (
+	"testing"
+
+	. "github.com/platsko/go-kit/crypto"
+	"github.com/platsko/go-kit/errors"
+)
+
+func Test_RegisterNetwork(t *testing.T) {
+	t.Parallel()
+
+	tests := [4]struct {
+		name    string
+		network string
+		ver     byte
+		wantErr error
+	}{
+		{
+			name:    "OK",
+			network: "regnet",
+			ver:     0x42,
+		},
+		{
+			name:    "duplicate_name_ERR",
+			network: MainNet,
+			ver:     0x43,
+			wantErr: ErrNetworkAlreadyRegistered(),
+		},
+		{
+			name:    "duplicate_version_ERR",
+			network: "othernet",
+			ver:     TestNetVersion,
+			wantErr: ErrNetworkAlreadyRegistered(),
+		},
+		{
+			name:    "empty_name_ERR",
+			ver:     0x44,
+			wantErr: ErrInvalidNetwork(),
+		},
+	}
+
+	for idx := range tests {
+		test := tests[idx]
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := RegisterNetwork(test.network, test.ver)
+			if !errors.Is(err, test.wantErr) {
+				t.Errorf("RegisterNetwork() error: %v | want: %v", err, test.wantErr)
+				return
+			}
+			if test.wantErr != nil {
+				return
+			}
+
+			want := Network{Name: test.network, Version: test.ver}
+			if got, ok := GetNetwork(test.network); !ok || got != want {
+				t.Errorf("GetNetwork() got: %v | want: %v", got, want)
+			}
+			if got, ok := GetNetworkByVersion(test.ver); !ok || got != want {
+				t.Errorf("GetNetworkByVersion() got: %v | want: %v", got, want)
+			}
+		})
+	}
+}
+
+func Test_GetNetworks(t *testing.T) {
+	t.Parallel()
+
+	list := GetNetworks()
+	if len(list) < 2 {
+		t.Errorf("GetNetworks() got: %v | want at least: %v", len(list), 2)
+		return
+	}
+	for i := 1; i < len(list); i++ {
+		if list[i-1].Version >= list[i].Version {
+			t.Errorf("GetNetworks() got unsorted: %v", list)
+			return
+		}
+	}
+}
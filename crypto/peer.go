@@ -0,0 +1,80 @@
+// Copyright © 2020-2021 The EVEN Solutions Developers Team
+
+package crypto
+
+import (
+	"github.com/libp2p/go-libp2p-core/peer"
+	ma "github.com/multiformats/go-multiaddr"
+
+	"github.com/platsko/go-kit/errors"
+)
+
+// PeerIDFromPublicKey returns the libp2p peer id derived from the public key.
+func PeerIDFromPublicKey(pbKey PublicKey) (peer.ID, error) {
+	if pbKey == nil {
+		return "", ErrPublicKeyCannotBeNil()
+	}
+
+	ki := pbKey.Libp2pKey()
+	if ki == nil {
+		return "", errors.ErrNilPointerValue()
+	}
+
+	return peer.IDFromPublicKey(ki)
+}
+
+// PeerIDFromPrivateKey returns the libp2p peer id derived from the private key.
+func PeerIDFromPrivateKey(prKey PrivateKey) (peer.ID, error) {
+	if prKey == nil {
+		return "", errors.ErrNilPointerValue()
+	}
+
+	return PeerIDFromPublicKey(prKey.PublicKey())
+}
+
+// PublicKeyFromPeerID extracts the public key inlined into the peer id.
+// Only small keys (e.g. Ed25519 and Secp256k1) are inlined by libp2p,
+// peer ids of the other keys contain the hash of the key only
+// and peer.ErrNoPublicKey is returned for them.
+func PublicKeyFromPeerID(id peer.ID) (PublicKey, error) {
+	ki, err := id.ExtractPublicKey()
+	if err != nil {
+		return nil, err
+	}
+
+	return NewPublicKey(ki), nil
+}
+
+// PeerMultiaddr returns the /p2p multiaddr of the peer id
+// derived from the public key, encapsulated into the transport address if any.
+func PeerMultiaddr(pbKey PublicKey, transport ma.Multiaddr) (ma.Multiaddr, error) {
+	id, err := PeerIDFromPublicKey(pbKey)
+	if err != nil {
+		return nil, err
+	}
+
+	addr, err := ma.NewComponent(ma.ProtocolWithCode(ma.P_P2P).Name, peer.Encode(id))
+	if err != nil {
+		return nil, err
+	}
+	if transport == nil {
+		return addr, nil
+	}
+
+	return transport.Encapsulate(addr), nil
+}
+
+// PublicKeyFromMultiaddr extracts the public key
+// from the peer id inlined into the /p2p multiaddr.
+func PublicKeyFromMultiaddr(addr ma.Multiaddr) (PublicKey, error) {
+	if addr == nil {
+		return nil, errors.ErrNilPointerValue()
+	}
+
+	_, id := peer.SplitAddr(addr)
+	if id == "" {
+		return nil, ErrPeerIDNotFound()
+	}
+
+	return PublicKeyFromPeerID(id)
+}
@@ -0,0 +1,198 @@
+// Copyright © 2020-2021 The EVEN Solutions Developers Team
+
+package crypto_test
+
+import (
+	"testing"
+
+	"github.com/libp2p/go-libp2p-core/peer"
+	ma "github.com/multiformats/go-multiaddr"
+
+	. "github.com/platsko/go-kit/crypto"
+	"github.com/platsko/go-kit/errors"
+)
+
+func Benchmark_PeerIDFromPublicKey(b *testing.B) {
+	_, pbKey := mockGenerateKeyPair(Ed25519)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := PeerIDFromPublicKey(pbKey); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func Test_PeerIDFromPublicKey(t *testing.T) {
+	t.Parallel()
+
+	type (
+		testCase struct {
+			name       string
+			prKey      PrivateKey
+			pbKey      PublicKey
+			wantInline bool
+			wantErr    bool
+		}
+		testList []testCase
+	)
+
+	algos := GetAlgos()
+	tests := make(testList, 0, algos.Len()+2)
+	for name, algo := range algos {
+		prKey, pbKey := mockGenerateKeyPair(algo)
+		tests = append(tests, testCase{
+			name:       name + "_OK",
+			prKey:      prKey,
+			pbKey:      pbKey,
+			wantInline: algo == Ed25519 || algo == Secp256k1,
+		})
+	}
+	tests = append(tests, testCase{
+		name:    "nil_key_value_ERR",
+		prKey:   NewPrivateKey(nil),
+		pbKey:   NewPublicKey(nil),
+		wantErr: true,
+	}, testCase{
+		name:    "nil_key_ERR",
+		wantErr: true,
+	})
+
+	for idx := range tests {
+		test := tests[idx]
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			id, err := PeerIDFromPublicKey(test.pbKey)
+			if (err != nil) != test.wantErr {
+				t.Errorf("PeerIDFromPublicKey() error: %v | want: %v", err, test.wantErr)
+				return
+			}
+			if test.wantErr {
+				if _, err = PeerIDFromPrivateKey(test.prKey); err == nil {
+					t.Errorf("PeerIDFromPrivateKey() error: %v | want: %v", err, test.wantErr)
+				}
+				return
+			}
+			if !id.MatchesPublicKey(test.pbKey.Libp2pKey()) {
+				t.Errorf("PeerIDFromPublicKey() got: %v | want matching: %v", id, test.pbKey)
+			}
+
+			got, err := PeerIDFromPrivateKey(test.prKey)
+			if err != nil {
+				t.Errorf("PeerIDFromPrivateKey() error: %v | want: %v", err, false)
+				return
+			}
+			if got != id {
+				t.Errorf("PeerIDFromPrivateKey() got: %v | want: %v", got, id)
+			}
+
+			pbKey, err := PublicKeyFromPeerID(id)
+			if !test.wantInline {
+				if !errors.Is(err, peer.ErrNoPublicKey) {
+					t.Errorf("PublicKeyFromPeerID() error: %v | want: %v", err, peer.ErrNoPublicKey)
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("PublicKeyFromPeerID() error: %v | want: %v", err, false)
+				return
+			}
+			if !pbKey.Equals(test.pbKey) {
+				t.Errorf("PublicKeyFromPeerID() got: %v | want: %v", pbKey, test.pbKey)
+			}
+		})
+	}
+}
+
+func Test_PeerMultiaddr(t *testing.T) {
+	t.Parallel()
+
+	_, pbKey := mockGenerateKeyPair(Ed25519)
+	id, err := PeerIDFromPublicKey(pbKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	transport := ma.StringCast("/ip4/127.0.0.1/tcp/4001")
+	tests := [3]struct {
+		name      string
+		pbKey     PublicKey
+		transport ma.Multiaddr
+		wantErr   bool
+	}{
+		{
+			name:  "OK",
+			pbKey: pbKey,
+		},
+		{
+			name:      "transport_OK",
+			pbKey:     pbKey,
+			transport: transport,
+		},
+		{
+			name:    "ERR",
+			wantErr: true,
+		},
+	}
+
+	for idx := range tests {
+		test := tests[idx]
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			addr, err := PeerMultiaddr(test.pbKey, test.transport)
+			if (err != nil) != test.wantErr {
+				t.Errorf("PeerMultiaddr() error: %v | want: %v", err, test.wantErr)
+				return
+			}
+			if test.wantErr {
+				return
+			}
+
+			tpt, got := peer.SplitAddr(addr)
+			if got != id || (test.transport != nil && !tpt.Equal(test.transport)) {
+				t.Errorf("PeerMultiaddr() got: %v | want: %v", addr, id)
+			}
+
+			pbKey, err := PublicKeyFromMultiaddr(addr)
+			if err != nil {
+				t.Errorf("PublicKeyFromMultiaddr() error: %v | want: %v", err, false)
+				return
+			}
+			if !pbKey.Equals(test.pbKey) {
+				t.Errorf("PublicKeyFromMultiaddr() got: %v | want: %v", pbKey, test.pbKey)
+			}
+		})
+	}
+}
+
+func Test_PublicKeyFromMultiaddr_ERR(t *testing.T) {
+	t.Parallel()
+
+	tests := [2]struct {
+		name    string
+		addr    ma.Multiaddr
+		wantErr error
+	}{
+		{
+			name:    "no_peer_id_ERR",
+			addr:    ma.StringCast("/ip4/127.0.0.1/tcp/4001"),
+			wantErr: ErrPeerIDNotFound(),
+		},
+		{
+			name:    "nil_ERR",
+			wantErr: errors.ErrNilPointerValue(),
+		},
+	}
+
+	for idx := range tests {
+		test := tests[idx]
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			if _, err := PublicKeyFromMultiaddr(test.addr); !errors.Is(err, test.wantErr) {
+				t.Errorf("PublicKeyFromMultiaddr() error: %v | want: %v", err, test.wantErr)
+			}
+		})
+	}
+}
@@ -14,11 +14,21 @@ type (
 		// Embedded Signer interface.
 		Signer
 
+		// Embedded HashSigner interface.
+		HashSigner
+
 		// Algo returns the private key Algo.
 		Algo() Algo
 
+		// Libp2pKey returns the underlying libp2p private key.
+		Libp2pKey() cc.PrivKey
+
 		// PublicKey returns the public key paired with this private key.
 		PublicKey() PublicKey
+
+		// SignMessage signs the message as it is with the algo specific scheme,
+		// it is used for interop with external formats, e.g. JWS.
+		SignMessage([]byte) (Signature, error)
 	}
 
 	// privateKey implements PrivateKey interface.
@@ -48,6 +58,11 @@ func (c *privateKey) Algo() Algo {
 	return Algo(algo)
 }
 
+// Libp2pKey implements PrivateKey.Libp2pKey method of interface.
+func (c *privateKey) Libp2pKey() cc.PrivKey {
+	return c.ki
+}
+
 // PublicKey implements PrivateKey.PublicKey method of interface.
 func (c *privateKey) PublicKey() PublicKey {
 	pbKey := publicKey{ki: nil}
@@ -76,13 +91,54 @@ func (c *privateKey) Sign(signable Signable) (Signature, error) {
 		return nil, err
 	}
 
-	blob, err := c.ki.Sign(h256[:])
+	sign, err := c.SignDigest(h256)
 	if err != nil {
 		return nil, err
 	}
 
-	sign := NewSignature(blob)
 	signable.SetSignature(sign)
 
 	return sign, nil
 }
+
+// SignDigest implements HashSigner.SignDigest method of interface.
+func (c *privateKey) SignDigest(h256 Hash256) (Signature, error) {
+	return c.SignMessage(h256[:])
+}
+
+// SignMessage implements PrivateKey.SignMessage method of interface.
+func (c *privateKey) SignMessage(msg []byte) (Signature, error) {
+	if c.ki == nil {
+		return nil, errors.ErrNilPointerValue()
+	}
+
+	blob, err := c.ki.Sign(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewSignature(blob), nil
+}
+
+// SignHasher implements HashSigner.SignHasher method of interface.
+func (c *privateKey) SignHasher(hasher Hasher) (Signature, PublicKey, error) {
+	if hasher == nil {
+		return nil, nil, ErrHasherCannotBeNil()
+	}
+
+	if c.ki == nil {
+		return nil, nil, errors.ErrNilPointerValue()
+	}
+
+	h256, err := hasher.Hash()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	sign, err := c.SignDigest(h256)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return sign, c.PublicKey(), nil
+}
@@ -8,6 +8,7 @@ import (
 
 	cc "github.com/libp2p/go-libp2p-core/crypto"
 
+	"github.com/platsko/go-kit/bytes"
 	. "github.com/platsko/go-kit/crypto"
 )
 
@@ -235,3 +236,213 @@ func Test_privateKey_Sign(t *testing.T) {
 		})
 	}
 }
+
+func Benchmark_privateKey_SignDigest(b *testing.B) {
+	prKey, _ := mockGenerateKeyPair(Ed25519)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := prKey.SignDigest(h256); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func Benchmark_privateKey_SignHasher(b *testing.B) {
+	signable, prKey := mockSignable(Ed25519, 1024)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, _, err := prKey.SignHasher(signable); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func Test_privateKey_SignDigest(t *testing.T) {
+	t.Parallel()
+
+	type (
+		testCase struct {
+			name    string
+			prKey   PrivateKey
+			h256    Hash256
+			wantErr bool
+		}
+		testList []testCase
+	)
+
+	algos := GetAlgos()
+	tests := make(testList, 0, algos.Len()+1)
+	for name, algo := range algos {
+		prKey, _ := mockGenerateKeyPair(algo)
+		tests = append(tests, testCase{
+			name:  name + "_OK",
+			prKey: prKey,
+			h256:  h256,
+		})
+	}
+
+	tests = append(tests, testCase{
+		name:    "nil_pointer_PrivateKey_ERR",
+		prKey:   NewPrivateKey(nil),
+		h256:    h256,
+		wantErr: true,
+	})
+
+	for idx := range tests {
+		test := tests[idx]
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			sign, err := test.prKey.SignDigest(test.h256)
+			if (err != nil) != test.wantErr {
+				t.Errorf("SignDigest() error: %v | want: %v", err, test.wantErr)
+				return
+			}
+			if test.wantErr {
+				return
+			}
+
+			got, err := test.prKey.PublicKey().VerifyDigest(test.h256, sign)
+			if err != nil {
+				t.Errorf("SignDigest() error: %v | want: %v", err, false)
+				return
+			}
+			if !got {
+				t.Errorf("SignDigest() got: %v | want: %v", got, true)
+			}
+		})
+	}
+}
+
+func Test_privateKey_SignHasher(t *testing.T) {
+	t.Parallel()
+
+	type (
+		testCase struct {
+			name     string
+			prKey    PrivateKey
+			signable *SignableStub
+			wantErr  bool
+		}
+		testList []testCase
+	)
+
+	algos := GetAlgos()
+	tests := make(testList, 0, algos.Len()+2)
+	for name, algo := range algos {
+		prKey, _ := mockGenerateKeyPair(algo)
+		tests = append(tests, testCase{
+			name:     name + "_OK",
+			prKey:    prKey,
+			signable: &SignableStub{Blob: bytes.RandBytes(1024)},
+		})
+	}
+
+	prKey, _ := mockGenerateKeyPair(Ed25519)
+	tests = append(tests, testCase{
+		name:     "nil_pointer_PrivateKey_ERR",
+		prKey:    NewPrivateKey(nil),
+		signable: &SignableStub{Blob: bytes.RandBytes(1024)},
+		wantErr:  true,
+	}, testCase{
+		name:     "nil_blob_Signable_ERR",
+		prKey:    prKey,
+		signable: &SignableStub{},
+		wantErr:  true,
+	})
+
+	for idx := range tests {
+		test := tests[idx]
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			sign, pbKey, err := test.prKey.SignHasher(test.signable)
+			if (err != nil) != test.wantErr {
+				t.Errorf("SignHasher() error: %v | want: %v", err, test.wantErr)
+				return
+			}
+			if test.signable.Sign != nil || test.signable.PbKey != nil {
+				t.Errorf("SignHasher() mutated signable: %#v", test.signable)
+			}
+			if test.wantErr {
+				return
+			}
+			if !pbKey.Equals(test.prKey.PublicKey()) {
+				t.Errorf("SignHasher() got: %v | want: %v", pbKey, test.prKey.PublicKey())
+			}
+
+			got, err := pbKey.VerifyHasher(test.signable, sign)
+			if err != nil {
+				t.Errorf("SignHasher() error: %v | want: %v", err, false)
+				return
+			}
+			if !got {
+				t.Errorf("SignHasher() got: %v | want: %v", got, true)
+			}
+		})
+	}
+
+	t.Run("nil_Hasher_ERR", func(t *testing.T) {
+		t.Parallel()
+
+		if _, _, err := prKey.SignHasher(nil); err == nil {
+			t.Errorf("SignHasher() error: %v | want: %v", err, true)
+		}
+	})
+}
+
+func Test_privateKey_Sign_PublicKey_Hashed(t *testing.T) {
+	t.Parallel()
+
+	algos := GetAlgos()
+	for name, algo := range algos {
+		algo := algo
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			prKey, _ := mockGenerateKeyPair(algo)
+			pbKey := prKey.PublicKey()
+
+			// Sign sets the public key before hashing,
+			// so the signature covers the public key.
+			signable := mockSignableKey(1024)
+			sign, err := prKey.Sign(signable)
+			if err != nil {
+				t.Fatalf("Sign() error: %v | want: %v", err, false)
+			}
+			if !signable.PbKey.Equals(pbKey) || !signable.Sign.Equals(sign) {
+				t.Errorf("Sign() got: %#v | want public key and signature set", signable)
+			}
+			if ok, _ := pbKey.Verify(signable); !ok {
+				t.Errorf("Verify() got: %v | want: %v", ok, true)
+			}
+
+			// SignHasher hashes the object as it is, so the public key
+			// set after signing invalidates the signature.
+			unset := mockSignableKey(1024)
+			sign, _, err = prKey.SignHasher(unset)
+			if err != nil {
+				t.Fatalf("SignHasher() error: %v | want: %v", err, false)
+			}
+			if ok, _ := pbKey.VerifyHasher(unset, sign); !ok {
+				t.Errorf("VerifyHasher() got: %v | want: %v", ok, true)
+			}
+			unset.SetPublicKey(pbKey)
+			if ok, _ := pbKey.VerifyHasher(unset, sign); ok {
+				t.Errorf("VerifyHasher() got: %v | want: %v", ok, false)
+			}
+
+			// SignHasher covers the public key set by the caller before signing.
+			preset := mockSignableKey(1024)
+			preset.SetPublicKey(pbKey)
+			sign, _, err = prKey.SignHasher(preset)
+			if err != nil {
+				t.Fatalf("SignHasher() error: %v | want: %v", err, false)
+			}
+			preset.SetSignature(sign)
+			if ok, _ := pbKey.Verify(preset); !ok {
+				t.Errorf("Verify() got: %v | want: %v", ok, true)
+			}
+		})
+	}
+}
@@ -0,0 +1,280 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.25.0
+// 	protoc        v3.14.0
+// source: crypto/proto/rotation.proto
+
+package pb
+
+import (
+	proto "github.com/golang/protobuf/proto"
+	pb "github.com/platsko/go-kit/timestamp/proto/pb"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// This is a compile-time assertion that a sufficiently up-to-date version
+// of the legacy proto package is being used.
+const _ = proto.ProtoPackageIsVersion4
+
+type RotationCert struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pbkey     *PublicKey    `protobuf:"bytes,1,opt,name=pbkey,proto3" json:"pbkey,omitempty"`
+	NewKey    *PublicKey    `protobuf:"bytes,2,opt,name=new_key,json=newKey,proto3" json:"new_key,omitempty"`
+	NotBefore *pb.Timestamp `protobuf:"bytes,3,opt,name=not_before,json=notBefore,proto3" json:"not_before,omitempty"`
+	NotAfter  *pb.Timestamp `protobuf:"bytes,4,opt,name=not_after,json=notAfter,proto3" json:"not_after,omitempty"`
+	Sign      *Signature    `protobuf:"bytes,5,opt,name=sign,proto3" json:"sign,omitempty"`
+}
+
+func (x *RotationCert) Reset() {
+	*x = RotationCert{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_crypto_proto_rotation_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RotationCert) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RotationCert) ProtoMessage() {}
+
+func (x *RotationCert) ProtoReflect() protoreflect.Message {
+	mi := &file_crypto_proto_rotation_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RotationCert.ProtoReflect.Descriptor instead.
+func (*RotationCert) Descriptor() ([]byte, []int) {
+	return file_crypto_proto_rotation_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *RotationCert) GetPbkey() *PublicKey {
+	if x != nil {
+		return x.Pbkey
+	}
+	return nil
+}
+
+func (x *RotationCert) GetNewKey() *PublicKey {
+	if x != nil {
+		return x.NewKey
+	}
+	return nil
+}
+
+func (x *RotationCert) GetNotBefore() *pb.Timestamp {
+	if x != nil {
+		return x.NotBefore
+	}
+	return nil
+}
+
+func (x *RotationCert) GetNotAfter() *pb.Timestamp {
+	if x != nil {
+		return x.NotAfter
+	}
+	return nil
+}
+
+func (x *RotationCert) GetSign() *Signature {
+	if x != nil {
+		return x.Sign
+	}
+	return nil
+}
+
+type RotationChain struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Certs []*RotationCert `protobuf:"bytes,1,rep,name=certs,proto3" json:"certs,omitempty"`
+}
+
+func (x *RotationChain) Reset() {
+	*x = RotationChain{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_crypto_proto_rotation_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RotationChain) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RotationChain) ProtoMessage() {}
+
+func (x *RotationChain) ProtoReflect() protoreflect.Message {
+	mi := &file_crypto_proto_rotation_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RotationChain.ProtoReflect.Descriptor instead.
+func (*RotationChain) Descriptor() ([]byte, []int) {
+	return file_crypto_proto_rotation_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *RotationChain) GetCerts() []*RotationCert {
+	if x != nil {
+		return x.Certs
+	}
+	return nil
+}
+
+var File_crypto_proto_rotation_proto protoreflect.FileDescriptor
+
+var file_crypto_proto_rotation_proto_rawDesc = []byte{
+	0x0a, 0x1b, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x72,
+	0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x10, 0x6b,
+	0x69, 0x74, 0x2e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x18, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x70, 0x62,
+	0x6b, 0x65, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x63, 0x72, 0x79, 0x70, 0x74,
+	0x6f, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x73, 0x69, 0x67, 0x6e, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x1f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x22, 0xa4, 0x02, 0x0a, 0x0c, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x43, 0x65, 0x72, 0x74, 0x12, 0x31, 0x0a, 0x05, 0x70, 0x62, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6b, 0x69, 0x74, 0x2e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79,
+	0x52, 0x05, 0x70, 0x62, 0x6b, 0x65, 0x79, 0x12, 0x34, 0x0a, 0x07, 0x6e, 0x65, 0x77, 0x5f, 0x6b,
+	0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6b, 0x69, 0x74, 0x2e, 0x63,
+	0x72, 0x79, 0x70, 0x74, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x75, 0x62, 0x6c,
+	0x69, 0x63, 0x4b, 0x65, 0x79, 0x52, 0x06, 0x6e, 0x65, 0x77, 0x4b, 0x65, 0x79, 0x12, 0x3d, 0x0a,
+	0x0a, 0x6e, 0x6f, 0x74, 0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1e, 0x2e, 0x6b, 0x69, 0x74, 0x2e, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x09, 0x6e, 0x6f, 0x74, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x12, 0x3b, 0x0a, 0x09,
+	0x6e, 0x6f, 0x74, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1e, 0x2e, 0x6b, 0x69, 0x74, 0x2e, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x08, 0x6e, 0x6f, 0x74, 0x41, 0x66, 0x74, 0x65, 0x72, 0x12, 0x2f, 0x0a, 0x04, 0x73, 0x69, 0x67,
+	0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6b, 0x69, 0x74, 0x2e, 0x63, 0x72,
+	0x79, 0x70, 0x74, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x52, 0x04, 0x73, 0x69, 0x67, 0x6e, 0x22, 0x45, 0x0a, 0x0d, 0x52, 0x6f,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x12, 0x34, 0x0a, 0x05, 0x63,
+	0x65, 0x72, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6b, 0x69, 0x74,
+	0x2e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x6f,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x65, 0x72, 0x74, 0x52, 0x05, 0x63, 0x65, 0x72, 0x74,
+	0x73, 0x42, 0x2b, 0x5a, 0x29, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x70, 0x6c, 0x61, 0x74, 0x73, 0x6b, 0x6f, 0x2f, 0x67, 0x6f, 0x2d, 0x6b, 0x69, 0x74, 0x2f, 0x63,
+	0x72, 0x79, 0x70, 0x74, 0x6f, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x70, 0x62, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_crypto_proto_rotation_proto_rawDescOnce sync.Once
+	file_crypto_proto_rotation_proto_rawDescData = file_crypto_proto_rotation_proto_rawDesc
+)
+
+func file_crypto_proto_rotation_proto_rawDescGZIP() []byte {
+	file_crypto_proto_rotation_proto_rawDescOnce.Do(func() {
+		file_crypto_proto_rotation_proto_rawDescData = protoimpl.X.CompressGZIP(file_crypto_proto_rotation_proto_rawDescData)
+	})
+	return file_crypto_proto_rotation_proto_rawDescData
+}
+
+var file_crypto_proto_rotation_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_crypto_proto_rotation_proto_goTypes = []interface{}{
+	(*RotationCert)(nil),  // 0: kit.crypto.proto.RotationCert
+	(*RotationChain)(nil), // 1: kit.crypto.proto.RotationChain
+	(*PublicKey)(nil),     // 2: kit.crypto.proto.PublicKey
+	(*pb.Timestamp)(nil),  // 3: kit.timestamp.proto.Timestamp
+	(*Signature)(nil),     // 4: kit.crypto.proto.Signature
+}
+var file_crypto_proto_rotation_proto_depIdxs = []int32{
+	2, // 0: kit.crypto.proto.RotationCert.pbkey:type_name -> kit.crypto.proto.PublicKey
+	2, // 1: kit.crypto.proto.RotationCert.new_key:type_name -> kit.crypto.proto.PublicKey
+	3, // 2: kit.crypto.proto.RotationCert.not_before:type_name -> kit.timestamp.proto.Timestamp
+	3, // 3: kit.crypto.proto.RotationCert.not_after:type_name -> kit.timestamp.proto.Timestamp
+	4, // 4: kit.crypto.proto.RotationCert.sign:type_name -> kit.crypto.proto.Signature
+	0, // 5: kit.crypto.proto.RotationChain.certs:type_name -> kit.crypto.proto.RotationCert
+	6, // [6:6] is the sub-list for method output_type
+	6, // [6:6] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
+}
+
+func init() { file_crypto_proto_rotation_proto_init() }
+func file_crypto_proto_rotation_proto_init() {
+	if File_crypto_proto_rotation_proto != nil {
+		return
+	}
+	file_crypto_proto_pbkey_proto_init()
+	file_crypto_proto_sign_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_crypto_proto_rotation_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RotationCert); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_crypto_proto_rotation_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RotationChain); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_crypto_proto_rotation_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_crypto_proto_rotation_proto_goTypes,
+		DependencyIndexes: file_crypto_proto_rotation_proto_depIdxs,
+		MessageInfos:      file_crypto_proto_rotation_proto_msgTypes,
+	}.Build()
+	File_crypto_proto_rotation_proto = out.File
+	file_crypto_proto_rotation_proto_rawDesc = nil
+	file_crypto_proto_rotation_proto_goTypes = nil
+	file_crypto_proto_rotation_proto_depIdxs = nil
+}
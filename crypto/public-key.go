@@ -3,7 +3,6 @@
 package crypto
 
 import (
-	"encoding/base64"
 	"encoding/hex"
 
 	json "github.com/json-iterator/go"
@@ -25,6 +24,8 @@ type (
 		Algo() Algo
 
 		// Base64 encodes a public key to base64 string.
+		//
+		// Deprecated: use Text(Base64Encoding) instead.
 		Base64() (string, error)
 
 		// Decode sets decoded data from protobuf message.
@@ -36,10 +37,20 @@ type (
 		// Equals checks whether two public keys are the same.
 		Equals(PublicKey) bool
 
+		// Fingerprint returns short base58 encoded Hash224 of the public key.
+		Fingerprint() (string, error)
+
+		// FingerprintSSH returns SSH-style "SHA256:" prefixed base64 fingerprint,
+		// it matches "ssh-keygen -l" output, Secp256k1 keys are not supported.
+		FingerprintSSH() (string, error)
+
 		// Hash224 calculates SHA224 checksum
 		// over SHA256 checksum over public key value.
 		Hash224() (Hash224, error)
 
+		// Libp2pKey returns the underlying libp2p public key.
+		Libp2pKey() crypto.PubKey
+
 		// Marshal implements marshaler interface for types
 		// that can marshal themselves into bytes.
 		Marshal() ([]byte, error)
@@ -48,10 +59,16 @@ type (
 		// that can marshal themselves into valid JSON.
 		MarshalJSON() ([]byte, error)
 
+		// Randomart returns OpenSSH-style visual representation of the public key.
+		Randomart() (string, error)
+
 		// String implements stringer interface for types
 		// that can converts themselves to string format.
 		String() string
 
+		// Text encodes a public key to string with the text encoding.
+		Text(TextEncoding) (string, error)
+
 		// Unmarshal implements unmarshaler interface for types
 		// that can unmarshal bytes of themselves.
 		Unmarshal([]byte) error
@@ -62,6 +79,16 @@ type (
 
 		// Verify verifies signable object.
 		Verify(Signable) (bool, error)
+
+		// VerifyDigest verifies the signature over the given hash checksum.
+		VerifyDigest(Hash256, Signature) (bool, error)
+
+		// VerifyHasher verifies the signature over the hash of the hasher object.
+		VerifyHasher(Hasher, Signature) (bool, error)
+
+		// VerifyMessage verifies the signature over the message as it is
+		// with the algo specific scheme, it is used for interop with external formats.
+		VerifyMessage([]byte, Signature) (bool, error)
 	}
 
 	// publicKey implements PublicKey interface.
@@ -73,6 +100,15 @@ type (
 var (
 	// Make sure publicKey implements PublicKey interface.
 	_ PublicKey = (*publicKey)(nil)
+
+	// rawPublicKeyUnmarshallers maps supported algos
+	// to unmarshallers of raw public key bytes.
+	rawPublicKeyUnmarshallers = map[Algo]crypto.PubKeyUnmarshaller{
+		RSA:       crypto.UnmarshalRsaPublicKey,
+		Ed25519:   crypto.UnmarshalEd25519PublicKey,
+		Secp256k1: crypto.UnmarshalSecp256k1PublicKey,
+		ECDSA:     crypto.UnmarshalECDSAPublicKey,
+	}
 )
 
 // NewPublicKey returns PublicKey interface.
@@ -90,6 +126,31 @@ func DecodePublicKey(pbuf *pb.PublicKey) (PublicKey, error) {
 	return &pbKey, nil
 }
 
+// ParsePublicKeyText decodes the string to PublicKey of the algo with the text encoding,
+// the string is expected to encode raw public key bytes.
+func ParsePublicKeyText(algo Algo, enc TextEncoding, s string) (PublicKey, error) {
+	unmarshal, ok := rawPublicKeyUnmarshallers[algo]
+	if !ok {
+		return nil, ErrUnsupportedKeyType()
+	}
+
+	if !enc.IsValid() {
+		return nil, ErrUnknownTextEncoding()
+	}
+
+	blob, err := enc.DecodeString(s)
+	if err != nil {
+		return nil, ErrInvalidPublicKey()
+	}
+
+	ki, err := unmarshal(blob)
+	if err != nil {
+		return nil, ErrInvalidPublicKey()
+	}
+
+	return NewPublicKey(ki), nil
+}
+
 // Algo implements PublicKey.Algo method of interface.
 func (c *publicKey) Algo() Algo {
 	if c.ki == nil {
@@ -101,12 +162,7 @@ func (c *publicKey) Algo() Algo {
 
 // Base64 implements PublicKey.Base64 method of interface.
 func (c *publicKey) Base64() (string, error) {
-	b, err := c.Raw()
-	if err != nil {
-		return "", err
-	}
-
-	return base64.StdEncoding.EncodeToString(b), nil
+	return c.Text(Base64Encoding)
 }
 
 // Decode implements PublicKey.Decode method of interface.
@@ -152,6 +208,11 @@ func (c *publicKey) Hash224() (Hash224, error) {
 	return NewHash224(b), nil
 }
 
+// Libp2pKey implements PublicKey.Libp2pKey method of interface.
+func (c *publicKey) Libp2pKey() crypto.PubKey {
+	return c.ki
+}
+
 // Marshal implements PublicKey.Marshal method of interface.
 func (c *publicKey) Marshal() ([]byte, error) {
 	pbuf, err := c.Encode()
@@ -191,6 +252,11 @@ func (c *publicKey) String() string {
 	return hex.EncodeToString(b)
 }
 
+// Text implements PublicKey.Text method of interface.
+func (c *publicKey) Text(enc TextEncoding) (string, error) {
+	return encodeTextRaw(enc, c.Raw)
+}
+
 // Unmarshal implements PublicKey.Unmarshal method of interface.
 func (c *publicKey) Unmarshal(b []byte) error {
 	pbuf := new(pb.PublicKey)
@@ -221,20 +287,50 @@ func (c *publicKey) Verify(signable Signable) (bool, error) {
 		return false, ErrSignableCannotBeNil()
 	}
 
-	sign := signable.GetSignature()
+	return c.VerifyHasher(signable, signable.GetSignature())
+}
+
+// VerifyDigest implements PublicKey.VerifyDigest method of interface.
+func (c *publicKey) VerifyDigest(h256 Hash256, sign Signature) (bool, error) {
+	return c.VerifyMessage(h256[:], sign)
+}
+
+// VerifyHasher implements PublicKey.VerifyHasher method of interface.
+func (c *publicKey) VerifyHasher(hasher Hasher, sign Signature) (bool, error) {
+	if c.ki == nil {
+		return false, ErrPublicKeyCannotBeNil()
+	}
+
+	if hasher == nil {
+		return false, ErrHasherCannotBeNil()
+	}
+
 	if sign == nil {
 		return false, ErrSignatureCannotBeNil()
 	}
 
-	blob, err := sign.Raw()
+	h256, err := hasher.Hash()
 	if err != nil {
 		return false, err
 	}
 
-	hash, err := signable.Hash()
+	return c.VerifyDigest(h256, sign)
+}
+
+// VerifyMessage implements PublicKey.VerifyMessage method of interface.
+func (c *publicKey) VerifyMessage(msg []byte, sign Signature) (bool, error) {
+	if c.ki == nil {
+		return false, ErrPublicKeyCannotBeNil()
+	}
+
+	if sign == nil {
+		return false, ErrSignatureCannotBeNil()
+	}
+
+	blob, err := sign.Raw()
 	if err != nil {
 		return false, err
 	}
 
-	return c.ki.Verify(hash[:], blob)
+	return c.ki.Verify(msg, blob)
 }
@@ -908,3 +908,181 @@ func Test_publicKey_Verify(t *testing.T) {
 		})
 	}
 }
+
+func Benchmark_publicKey_VerifyDigest(b *testing.B) {
+	prKey, pbKey := mockGenerateKeyPair(Ed25519)
+	sign, err := prKey.SignDigest(h256)
+	if err != nil {
+		b.Fatal(err)
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if ok, _ := pbKey.VerifyDigest(h256, sign); !ok {
+			b.Fatalf("VerifyDigest() got: %v | want: true", ok)
+		}
+	}
+}
+
+func Benchmark_publicKey_VerifyHasher(b *testing.B) {
+	signable, prKey := mockSignable(Ed25519, 1024)
+	sign, pbKey, err := prKey.SignHasher(signable)
+	if err != nil {
+		b.Fatal(err)
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if ok, _ := pbKey.VerifyHasher(signable, sign); !ok {
+			b.Fatalf("VerifyHasher() got: %v | want: true", ok)
+		}
+	}
+}
+
+func Test_publicKey_VerifyDigest(t *testing.T) {
+	t.Parallel()
+
+	type (
+		testCase struct {
+			name    string
+			pbKey   PublicKey
+			h256    Hash256
+			sign    Signature
+			want    bool
+			wantErr bool
+		}
+		testList []testCase
+	)
+
+	algos := GetAlgos()
+	tests := make(testList, 0, algos.Len()*2+3)
+	for name, algo := range algos {
+		_, notEq := mockGenerateKeyPair(algo)
+		prKey, pbKey := mockGenerateKeyPair(algo)
+		sign, err := prKey.SignDigest(h256)
+		if err != nil {
+			t.Fatal(err)
+		}
+		tests = append(tests, testCase{
+			name:  name + "_TRUE",
+			pbKey: pbKey,
+			h256:  h256,
+			sign:  sign,
+			want:  true,
+		}, testCase{
+			name:    name + "_FALSE",
+			pbKey:   notEq,
+			h256:    h256,
+			sign:    sign,
+			wantErr: algo == RSA,
+		})
+	}
+
+	_, pbKey := mockGenerateKeyPair(Ed25519)
+	tests = append(tests, testCase{
+		name:    "nil_ki_PublicKey_ERR",
+		pbKey:   NewPublicKey(nil),
+		wantErr: true,
+	}, testCase{
+		name:    "nil_Signature_ERR",
+		pbKey:   pbKey,
+		wantErr: true,
+	}, testCase{
+		name:    "nil_blob_Signature_ERR",
+		pbKey:   pbKey,
+		sign:    NewSignature(nil),
+		wantErr: true,
+	})
+
+	for idx := range tests {
+		test := tests[idx]
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := test.pbKey.VerifyDigest(test.h256, test.sign)
+			if (err != nil) != test.wantErr {
+				t.Errorf("VerifyDigest() error: %v | want: %v", err, test.wantErr)
+				return
+			}
+			if got != test.want {
+				t.Errorf("VerifyDigest() got: %v | want: %v", got, test.want)
+			}
+		})
+	}
+}
+
+func Test_publicKey_VerifyHasher(t *testing.T) {
+	t.Parallel()
+
+	type (
+		testCase struct {
+			name    string
+			pbKey   PublicKey
+			hasher  Hasher
+			sign    Signature
+			want    bool
+			wantErr bool
+		}
+		testList []testCase
+	)
+
+	algos := GetAlgos()
+	tests := make(testList, 0, algos.Len()*2+4)
+	for name, algo := range algos {
+		_, notEq := mockGenerateKeyPair(algo)
+		signable, prKey := mockSignable(algo, 1024)
+		tests = append(tests, testCase{
+			name:   name + "_TRUE",
+			pbKey:  prKey.PublicKey(),
+			hasher: signable,
+			sign:   signable.GetSignature(),
+			want:   true,
+		}, testCase{
+			name:    name + "_FALSE",
+			pbKey:   notEq,
+			hasher:  signable,
+			sign:    signable.GetSignature(),
+			wantErr: algo == RSA,
+		})
+	}
+
+	signable, prKey := mockSignable(Ed25519, 1024)
+	pbKey := prKey.PublicKey()
+	tests = append(tests, testCase{
+		name:    "nil_ki_PublicKey_ERR",
+		pbKey:   NewPublicKey(nil),
+		hasher:  signable,
+		sign:    signable.GetSignature(),
+		wantErr: true,
+	}, testCase{
+		name:    "nil_Hasher_ERR",
+		pbKey:   pbKey,
+		sign:    signable.GetSignature(),
+		wantErr: true,
+	}, testCase{
+		name:    "nil_blob_Hasher_ERR",
+		pbKey:   pbKey,
+		hasher:  &SignableStub{},
+		sign:    signable.GetSignature(),
+		wantErr: true,
+	}, testCase{
+		name:    "nil_Signature_ERR",
+		pbKey:   pbKey,
+		hasher:  signable,
+		wantErr: true,
+	})
+
+	for idx := range tests {
+		test := tests[idx]
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := test.pbKey.VerifyHasher(test.hasher, test.sign)
+			if (err != nil) != test.wantErr {
+				t.Errorf("VerifyHasher() error: %v | want: %v", err, test.wantErr)
+				return
+			}
+			if got != test.want {
+				t.Errorf("VerifyHasher() got: %v | want: %v", got, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,58 @@
+// Copyright © 2020-2021 The EVEN Solutions Developers Team
+
+package remote
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/binary"
+	"hash"
+
+	"github.com/platsko/go-kit/crypto/remote/proto/pb"
+)
+
+const (
+	// MinSecretSize defines min size in bytes of the shared secret.
+	MinSecretSize = 32
+
+	// NonceSize defines size in bytes of the request nonce.
+	NonceSize = 16
+)
+
+// requestMAC calculates HMAC-SHA256 checksum over the request fields.
+func requestMAC(secret []byte, req *pb.Request) []byte {
+	mac := hmac.New(sha256.New, secret)
+	writeUint64(mac, req.GetId())
+	writeField(mac, req.GetNonce())
+	writeField(mac, req.GetTime().GetBlob())
+	writeUint64(mac, uint64(req.GetMethod()))
+	writeField(mac, req.GetDigest())
+
+	return mac.Sum(nil)
+}
+
+// responseMAC calculates HMAC-SHA256 checksum over the response fields
+// bound to the nonce of the request it replies to.
+func responseMAC(secret, nonce []byte, resp *pb.Response) []byte {
+	mac := hmac.New(sha256.New, secret)
+	writeUint64(mac, resp.GetId())
+	writeField(mac, nonce)
+	writeField(mac, resp.GetPbkey().GetBlob())
+	writeField(mac, resp.GetSign().GetBlob())
+	writeField(mac, []byte(resp.GetError()))
+
+	return mac.Sum(nil)
+}
+
+// writeField writes length-prefixed bytes to the hash.
+func writeField(h hash.Hash, blob []byte) {
+	writeUint64(h, uint64(len(blob)))
+	_, _ = h.Write(blob)
+}
+
+// writeUint64 writes big-endian encoded number to the hash.
+func writeUint64(h hash.Hash, n uint64) {
+	b := [8]byte{}
+	binary.BigEndian.PutUint64(b[:], n)
+	_, _ = h.Write(b[:])
+}
@@ -0,0 +1,275 @@
+// Copyright © 2020-2021 The EVEN Solutions Developers Team
+
+package remote
+
+import (
+	"crypto/hmac"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/platsko/go-kit/bytes"
+	"github.com/platsko/go-kit/crypto"
+	"github.com/platsko/go-kit/crypto/remote/proto/pb"
+	"github.com/platsko/go-kit/errors"
+	"github.com/platsko/go-kit/timestamp"
+)
+
+const (
+	// DefaultTimeout defines the default timeout of the request round trip.
+	DefaultTimeout = 10 * time.Second
+)
+
+type (
+	// Client implements crypto.Signer and crypto.HashSigner interfaces
+	// over the connection to the remote signer server.
+	// Signatures received from the server are verified with its public key.
+	Client struct {
+		conn    net.Conn
+		dial    func() (net.Conn, error)
+		secret  []byte
+		timeout time.Duration
+
+		mutex sync.Mutex
+		seq   uint64
+		pbKey crypto.PublicKey
+	}
+)
+
+var (
+	// Make sure Client implements crypto.Signer interface.
+	_ crypto.Signer = (*Client)(nil)
+
+	// Make sure Client implements crypto.HashSigner interface.
+	_ crypto.HashSigner = (*Client)(nil)
+)
+
+// Dial connects to the remote signer server on the named network,
+// "unix" and "tcp" networks are supported.
+// The client redials the server if the connection was dropped after a failed call.
+func Dial(network, address string, secret []byte) (*Client, error) {
+	dial := func() (net.Conn, error) {
+		return net.DialTimeout(network, address, DefaultTimeout)
+	}
+
+	conn, err := dial()
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := NewClient(conn, secret)
+	if err != nil {
+		return nil, err
+	}
+
+	client.dial = dial
+
+	return client, nil
+}
+
+// NewClient constructs remote signer client over the connection
+// and requests the public key of the remote signer.
+// The connection is closed on failure and after a failed call,
+// the client constructed this way cannot be used anymore.
+func NewClient(conn net.Conn, secret []byte) (*Client, error) {
+	if conn == nil {
+		return nil, errors.ErrNilPointerValue()
+	}
+
+	if len(secret) < MinSecretSize {
+		_ = conn.Close()
+		return nil, ErrSecretTooShort() // nolint: nlreturn
+	}
+
+	client := Client{
+		conn:    conn,
+		secret:  append([]byte(nil), secret...),
+		timeout: DefaultTimeout,
+	}
+
+	pbKey, err := client.requestPublicKey()
+	if err != nil {
+		_ = conn.Close()
+		return nil, err // nolint: nlreturn
+	}
+
+	client.pbKey = pbKey
+
+	return &client, nil
+}
+
+// Close closes the connection to the remote signer.
+func (c *Client) Close() error {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if c.conn == nil {
+		return nil
+	}
+
+	err := c.conn.Close()
+	c.conn, c.dial = nil, nil
+
+	return err
+}
+
+// PublicKey implements crypto.HashSigner.PublicKey method of interface.
+func (c *Client) PublicKey() crypto.PublicKey {
+	return c.pbKey
+}
+
+// SetTimeout sets the timeout of the request round trip.
+func (c *Client) SetTimeout(timeout time.Duration) {
+	c.mutex.Lock()
+	c.timeout = timeout
+	c.mutex.Unlock()
+}
+
+// Sign implements crypto.Signer.Sign method of interface.
+func (c *Client) Sign(signable crypto.Signable) (crypto.Signature, error) {
+	if signable == nil {
+		return nil, crypto.ErrSignableCannotBeNil()
+	}
+
+	signable.SetPublicKey(c.pbKey)
+
+	h256, err := signable.Hash()
+	if err != nil {
+		return nil, err
+	}
+
+	sign, err := c.SignDigest(h256)
+	if err != nil {
+		return nil, err
+	}
+
+	signable.SetSignature(sign)
+
+	return sign, nil
+}
+
+// SignDigest implements crypto.HashSigner.SignDigest method of interface.
+func (c *Client) SignDigest(h256 crypto.Hash256) (crypto.Signature, error) {
+	resp, err := c.call(pb.Method_SIGN_DIGEST, h256[:])
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.GetSign() == nil {
+		return nil, crypto.ErrSignatureCannotBeNil()
+	}
+
+	sign := crypto.DecodeSignature(resp.GetSign())
+	if ok, _ := c.pbKey.VerifyDigest(h256, sign); !ok {
+		return nil, ErrInvalidSignature()
+	}
+
+	return sign, nil
+}
+
+// SignHasher implements crypto.HashSigner.SignHasher method of interface.
+func (c *Client) SignHasher(hasher crypto.Hasher) (crypto.Signature, crypto.PublicKey, error) {
+	if hasher == nil {
+		return nil, nil, crypto.ErrHasherCannotBeNil()
+	}
+
+	h256, err := hasher.Hash()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	sign, err := c.SignDigest(h256)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return sign, c.pbKey, nil
+}
+
+// call sends authenticated request and reads the response.
+// The connection is closed on any transport or protocol failure,
+// so a late response to the failed request is never read
+// as the response to the next one, the next call redials the server.
+func (c *Client) call(method pb.Method, digest []byte) (*pb.Response, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	ts := timestamp.Now()
+	pbts, err := ts.Encode()
+	if err != nil {
+		return nil, err
+	}
+
+	if c.conn == nil {
+		if c.dial == nil {
+			return nil, ErrConnectionClosed()
+		}
+		if c.conn, err = c.dial(); err != nil {
+			return nil, err
+		}
+	}
+
+	c.seq++
+	req := pb.Request{
+		Id:     c.seq,
+		Nonce:  bytes.RandBytes(NonceSize),
+		Time:   pbts,
+		Method: method,
+		Digest: digest,
+	}
+	req.Mac = requestMAC(c.secret, &req)
+
+	resp, err := c.roundTrip(&req)
+	if err != nil {
+		_ = c.conn.Close()
+		c.conn = nil
+		return nil, err // nolint: nlreturn
+	}
+
+	if resp.GetError() != "" {
+		return nil, ErrRemoteSigner(resp.GetError())
+	}
+
+	return resp, nil
+}
+
+// requestPublicKey requests the public key of the remote signer.
+func (c *Client) requestPublicKey() (crypto.PublicKey, error) {
+	resp, err := c.call(pb.Method_PUBLIC_KEY, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.GetPbkey() == nil {
+		return nil, ErrMessageMismatch()
+	}
+
+	return crypto.DecodePublicKey(resp.GetPbkey())
+}
+
+// roundTrip writes the request and reads the authenticated response to it.
+func (c *Client) roundTrip(req *pb.Request) (*pb.Response, error) {
+	if err := c.conn.SetDeadline(time.Now().Add(c.timeout)); err != nil {
+		return nil, err
+	}
+	defer func() { _ = c.conn.SetDeadline(time.Time{}) }()
+
+	if err := writeFrame(c.conn, req); err != nil {
+		return nil, err
+	}
+
+	resp := pb.Response{}
+	if err := readFrame(c.conn, &resp); err != nil {
+		return nil, err
+	}
+
+	if !hmac.Equal(resp.GetMac(), responseMAC(c.secret, req.GetNonce(), &resp)) {
+		return nil, ErrUnauthorized()
+	}
+
+	if resp.GetId() != req.GetId() {
+		return nil, ErrMessageMismatch()
+	}
+
+	return &resp, nil
+}
@@ -0,0 +1,282 @@
+// Copyright © 2020-2021 The EVEN Solutions Developers Team
+
+package remote_test
+
+import (
+	"io"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/platsko/go-kit/bytes"
+	"github.com/platsko/go-kit/crypto"
+	cpb "github.com/platsko/go-kit/crypto/proto/pb"
+	. "github.com/platsko/go-kit/crypto/remote"
+	"github.com/platsko/go-kit/crypto/remote/proto/pb"
+	"github.com/platsko/go-kit/errors"
+)
+
+func Benchmark_Client_SignDigest(b *testing.B) {
+	client, _ := mockLocalSigner(b, crypto.Ed25519)
+	h256 := crypto.NewHash256(bytes.RandBytes(1024))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := client.SignDigest(h256); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func Test_Dial(t *testing.T) {
+	t.Parallel()
+
+	tests := [4]struct {
+		name    string
+		network string
+		secret  []byte
+		wantErr bool
+	}{
+		{
+			name:    "unix_OK",
+			network: "unix",
+		},
+		{
+			name:    "tcp_OK",
+			network: "tcp",
+		},
+		{
+			name:    "wrong_secret_ERR",
+			network: "tcp",
+			secret:  mockSecret(),
+			wantErr: true,
+		},
+		{
+			name:    "short_secret_ERR",
+			network: "tcp",
+			secret:  bytes.RandBytes(MinSecretSize - 1),
+			wantErr: true,
+		},
+	}
+
+	for idx := range tests {
+		test := tests[idx]
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			prKey, secret := mockPrivateKey(crypto.Ed25519), mockSecret()
+			_, listener := mockServer(t, test.network, prKey, secret)
+			if test.secret != nil {
+				secret = test.secret
+			}
+
+			client, err := Dial(test.network, listener.Addr().String(), secret)
+			if (err != nil) != test.wantErr {
+				t.Errorf("Dial() error: %v | want: %v", err, test.wantErr)
+				return
+			}
+			if test.wantErr {
+				return
+			}
+			defer func() { _ = client.Close() }()
+
+			if got := client.PublicKey(); !got.Equals(prKey.PublicKey()) {
+				t.Errorf("PublicKey() got: %v | want: %v", got, prKey.PublicKey())
+			}
+		})
+	}
+}
+
+func Test_Dial_Unauthorized(t *testing.T) {
+	t.Parallel()
+
+	_, listener := mockServer(t, "tcp", mockPrivateKey(crypto.Ed25519), mockSecret())
+	_, err := Dial("tcp", listener.Addr().String(), mockSecret())
+	if !errors.Is(err, ErrUnauthorized()) {
+		t.Errorf("Dial() error: %v | want: %v", err, ErrUnauthorized())
+	}
+}
+
+func Test_Client_LateResponse(t *testing.T) {
+	t.Parallel()
+
+	prKey := mockSlowKey{PrivateKey: mockPrivateKey(crypto.Ed25519), delay: 300 * time.Millisecond}
+	secret := mockSecret()
+	_, listener := mockServer(t, "tcp", &prKey, secret)
+
+	client, err := Dial("tcp", listener.Addr().String(), secret)
+	if err != nil {
+		t.Fatalf("Dial() error: %v | want: %v", err, nil)
+	}
+	t.Cleanup(func() { _ = client.Close() })
+	client.SetTimeout(100 * time.Millisecond)
+
+	if _, err = client.SignDigest(crypto.NewHash256(bytes.RandBytes(1024))); err == nil {
+		t.Fatalf("SignDigest() error: %v | want: timeout", err)
+	}
+
+	h256 := crypto.NewHash256(bytes.RandBytes(1024))
+	sign, err := client.SignDigest(h256)
+	if err != nil {
+		t.Fatalf("SignDigest() error: %v | want: %v", err, nil)
+	}
+	if ok, err := prKey.PublicKey().VerifyDigest(h256, sign); !ok {
+		t.Errorf("VerifyDigest() got: %v | error: %v | want: %v", ok, err, true)
+	}
+}
+
+func Test_Client_Close(t *testing.T) {
+	t.Parallel()
+
+	client, _ := mockLocalSigner(t, crypto.Ed25519)
+	if err := client.Close(); err != nil {
+		t.Errorf("Close() error: %v | want: %v", err, nil)
+	}
+	if err := client.Close(); err != nil {
+		t.Errorf("Close() error: %v | want: %v", err, nil)
+	}
+
+	h256 := crypto.NewHash256(bytes.RandBytes(1024))
+	if _, err := client.SignDigest(h256); !errors.Is(err, ErrConnectionClosed()) {
+		t.Errorf("SignDigest() error: %v | want: %v", err, ErrConnectionClosed())
+	}
+}
+
+func Test_NewClient(t *testing.T) {
+	t.Parallel()
+
+	if _, err := NewClient(nil, mockSecret()); err == nil {
+		t.Errorf("NewClient() error: %v | want: %v", err, true)
+	}
+
+	tests := [3]struct {
+		name    string
+		secret  []byte
+		pbKey   *cpb.PublicKey
+		wantErr error
+	}{
+		{
+			name:    "short_secret_ERR",
+			secret:  mockSecret()[1:],
+			wantErr: ErrSecretTooShort(),
+		},
+		{
+			name:    "nil_public_key_ERR",
+			secret:  mockSecret(),
+			wantErr: ErrMessageMismatch(),
+		},
+		{
+			name:   "invalid_public_key_ERR",
+			secret: mockSecret(),
+			pbKey:  &cpb.PublicKey{Blob: []byte{1, 2, 3}},
+		},
+	}
+
+	for idx := range tests {
+		test := tests[idx]
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			cliConn, srvConn := net.Pipe()
+			defer func() { _ = srvConn.Close() }()
+			go func() {
+				req := pb.Request{}
+				if err := ReadFrame(srvConn, &req); err != nil {
+					return
+				}
+				resp := pb.Response{Id: req.GetId(), Pbkey: test.pbKey}
+				resp.Mac = ResponseMAC(test.secret, req.GetNonce(), &resp)
+				_ = WriteFrame(srvConn, &resp)
+			}()
+
+			_, err := NewClient(cliConn, test.secret)
+			if err == nil || test.wantErr != nil && !errors.Is(err, test.wantErr) {
+				t.Errorf("NewClient() error: %v | want: %v", err, test.wantErr)
+			}
+			if _, err = cliConn.Write([]byte{0}); !errors.Is(err, io.ErrClosedPipe) {
+				t.Errorf("Write() error: %v | want: %v", err, io.ErrClosedPipe)
+			}
+		})
+	}
+}
+
+func Test_Client_Sign(t *testing.T) {
+	t.Parallel()
+
+	algos := crypto.GetAlgos()
+	for name, algo := range algos {
+		algo := algo
+		t.Run(name+"_OK", func(t *testing.T) {
+			t.Parallel()
+
+			client, prKey := mockLocalSigner(t, algo)
+			signable := crypto.SignableStub{Blob: bytes.RandBytes(1024)}
+
+			sign, err := client.Sign(&signable)
+			if err != nil {
+				t.Fatalf("Sign() error: %v | want: %v", err, false)
+			}
+			if !signable.Sign.Equals(sign) || !signable.PbKey.Equals(prKey.PublicKey()) {
+				t.Errorf("Sign() got: %#v | want signature and public key set", signable)
+			}
+			if ok, err := prKey.PublicKey().Verify(&signable); !ok {
+				t.Errorf("Verify() got: %v | error: %v | want: %v", ok, err, true)
+			}
+		})
+	}
+
+	t.Run("nil_Signable_ERR", func(t *testing.T) {
+		t.Parallel()
+
+		client, _ := mockLocalSigner(t, crypto.Ed25519)
+		if _, err := client.Sign(nil); err == nil {
+			t.Errorf("Sign() error: %v | want: %v", err, true)
+		}
+	})
+}
+
+func Test_Client_SignHasher(t *testing.T) {
+	t.Parallel()
+
+	client, prKey := mockLocalSigner(t, crypto.Ed25519)
+	tests := [3]struct {
+		name    string
+		hasher  crypto.Hasher
+		wantErr bool
+	}{
+		{
+			name:   "OK",
+			hasher: &crypto.SignableStub{Blob: bytes.RandBytes(1024)},
+		},
+		{
+			name:    "nil_Hasher_ERR",
+			wantErr: true,
+		},
+		{
+			name:    "nil_blob_Hasher_ERR",
+			hasher:  &crypto.SignableStub{},
+			wantErr: true,
+		},
+	}
+
+	for idx := range tests {
+		test := tests[idx]
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			sign, pbKey, err := client.SignHasher(test.hasher)
+			if (err != nil) != test.wantErr {
+				t.Errorf("SignHasher() error: %v | want: %v", err, test.wantErr)
+				return
+			}
+			if test.wantErr {
+				return
+			}
+			if !pbKey.Equals(prKey.PublicKey()) {
+				t.Errorf("SignHasher() got: %v | want: %v", pbKey, prKey.PublicKey())
+			}
+			if ok, err := pbKey.VerifyHasher(test.hasher, sign); !ok {
+				t.Errorf("VerifyHasher() got: %v | error: %v | want: %v", ok, err, true)
+			}
+		})
+	}
+}
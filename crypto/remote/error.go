@@ -0,0 +1,79 @@
+// Copyright © 2020-2021 The EVEN Solutions Developers Team
+
+package remote
+
+import (
+	"github.com/platsko/go-kit/errors"
+)
+
+const (
+	ErrConnectionClosedMsg = "connection closed"
+	ErrFrameTooLargeMsg    = "frame too large"
+	ErrInvalidSignatureMsg = "invalid signature"
+	ErrMessageMismatchMsg  = "message mismatch"
+	ErrRemoteSignerMsg     = "remote signer"
+	ErrReplayedRequestMsg  = "replayed request"
+	ErrSecretTooShortMsg   = "secret too short"
+	ErrServerClosedMsg     = "server closed"
+	ErrStaleRequestMsg     = "stale request"
+	ErrUnauthorizedMsg     = "unauthorized"
+	ErrUnknownMethodMsg    = "unknown method"
+)
+
+var (
+	errConnectionClosed = errors.New(ErrConnectionClosedMsg)
+	errFrameTooLarge    = errors.New(ErrFrameTooLargeMsg)
+	errInvalidSignature = errors.New(ErrInvalidSignatureMsg)
+	errMessageMismatch  = errors.New(ErrMessageMismatchMsg)
+	errReplayedRequest  = errors.New(ErrReplayedRequestMsg)
+	errSecretTooShort   = errors.New(ErrSecretTooShortMsg)
+	errServerClosed     = errors.New(ErrServerClosedMsg)
+	errStaleRequest     = errors.New(ErrStaleRequestMsg)
+	errUnauthorized     = errors.New(ErrUnauthorizedMsg)
+	errUnknownMethod    = errors.New(ErrUnknownMethodMsg)
+)
+
+func ErrConnectionClosed() error {
+	return errConnectionClosed
+}
+
+func ErrFrameTooLarge() error {
+	return errFrameTooLarge
+}
+
+func ErrInvalidSignature() error {
+	return errInvalidSignature
+}
+
+func ErrMessageMismatch() error {
+	return errMessageMismatch
+}
+
+// ErrRemoteSigner wraps the error message received from remote signer.
+func ErrRemoteSigner(msg string) error {
+	return errors.WrapStr(ErrRemoteSignerMsg, msg)
+}
+
+func ErrReplayedRequest() error {
+	return errReplayedRequest
+}
+
+func ErrSecretTooShort() error {
+	return errSecretTooShort
+}
+
+func ErrServerClosed() error {
+	return errServerClosed
+}
+
+func ErrStaleRequest() error {
+	return errStaleRequest
+}
+
+func ErrUnauthorized() error {
+	return errUnauthorized
+}
+
+func ErrUnknownMethod() error {
+	return errUnknownMethod
+}
@@ -0,0 +1,11 @@
+// Copyright © 2020-2021 The EVEN Solutions Developers Team
+
+package remote
+
+var (
+	// Export unexported functions for testing.
+	ReadFrame   = readFrame
+	RequestMAC  = requestMAC
+	ResponseMAC = responseMAC
+	WriteFrame  = writeFrame
+)
@@ -0,0 +1,58 @@
+// Copyright © 2020-2021 The EVEN Solutions Developers Team
+
+package remote
+
+import (
+	"encoding/binary"
+	"io"
+
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// MaxFrameSize defines max size in bytes of the message frame.
+	MaxFrameSize = 64 * 1024
+
+	// frameHeaderSize defines size in bytes of the frame length prefix.
+	frameHeaderSize = 4
+)
+
+// readFrame reads length-prefixed protobuf message from the reader.
+func readFrame(r io.Reader, msg proto.Message) error {
+	header := [frameHeaderSize]byte{}
+	if _, err := io.ReadFull(r, header[:]); err != nil {
+		return err
+	}
+
+	size := binary.BigEndian.Uint32(header[:])
+	if size > MaxFrameSize {
+		return ErrFrameTooLarge()
+	}
+
+	blob := make([]byte, size)
+	if _, err := io.ReadFull(r, blob); err != nil {
+		return err
+	}
+
+	return proto.Unmarshal(blob, msg)
+}
+
+// writeFrame writes protobuf message to the writer prefixed with its length.
+func writeFrame(w io.Writer, msg proto.Message) error {
+	blob, err := proto.Marshal(msg)
+	if err != nil {
+		return err
+	}
+
+	if len(blob) > MaxFrameSize {
+		return ErrFrameTooLarge()
+	}
+
+	frame := make([]byte, frameHeaderSize, frameHeaderSize+len(blob))
+	binary.BigEndian.PutUint32(frame, uint32(len(blob)))
+	frame = append(frame, blob...)
+
+	_, err = w.Write(frame)
+
+	return err
+}
@@ -0,0 +1,27 @@
+// Copyright © 2020-2021 The EVEN Solutions Developers Team
+
+package remote
+
+import (
+	"net"
+
+	"github.com/platsko/go-kit/bytes"
+	"github.com/platsko/go-kit/crypto"
+)
+
+// NewLocalSigner returns the client connected to the in-process server
+// over the synchronous in-memory pipe, it is a stand-in of the remote signer
+// for tests. Closing the client releases the server side of the pipe.
+func NewLocalSigner(prKey crypto.PrivateKey) (*Client, error) {
+	secret := bytes.RandBytes(MinSecretSize)
+
+	server, err := NewServer(prKey, secret)
+	if err != nil {
+		return nil, err
+	}
+
+	cliConn, srvConn := net.Pipe()
+	go server.ServeConn(srvConn)
+
+	return NewClient(cliConn, secret)
+}
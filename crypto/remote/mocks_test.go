@@ -0,0 +1,83 @@
+// Copyright © 2020-2021 The EVEN Solutions Developers Team
+
+package remote_test
+
+import (
+	"net"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/platsko/go-kit/bytes"
+	"github.com/platsko/go-kit/crypto"
+	. "github.com/platsko/go-kit/crypto/remote"
+)
+
+func mockPrivateKey(algo crypto.Algo) crypto.PrivateKey {
+	prKey, _, err := crypto.GenerateKeyPair(algo)
+	if err != nil {
+		panic(err)
+	}
+
+	return prKey
+}
+
+func mockSecret() []byte {
+	return bytes.RandBytes(MinSecretSize)
+}
+
+func mockListener(t testing.TB, network string) net.Listener {
+	address := "127.0.0.1:0"
+	if network == "unix" {
+		address = filepath.Join(t.TempDir(), "signer.sock")
+	}
+
+	listener, err := net.Listen(network, address)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return listener
+}
+
+func mockServer(t testing.TB, network string, prKey crypto.PrivateKey, secret []byte) (*Server, net.Listener) {
+	server, err := NewServer(prKey, secret)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	listener := mockListener(t, network)
+	go func() { _ = server.Serve(listener) }()
+	t.Cleanup(func() { _ = server.Close() })
+
+	return server, listener
+}
+
+func mockLocalSigner(t testing.TB, algo crypto.Algo) (*Client, crypto.PrivateKey) {
+	prKey := mockPrivateKey(algo)
+
+	client, err := NewLocalSigner(prKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = client.Close() })
+
+	return client, prKey
+}
+
+// mockSlowKey delays the first digest signing to answer after the client timeout.
+type mockSlowKey struct {
+	crypto.PrivateKey
+	delay time.Duration
+	calls int32
+}
+
+// SignDigest implements crypto.HashSigner interface.
+func (k *mockSlowKey) SignDigest(h256 crypto.Hash256) (crypto.Signature, error) {
+	if atomic.AddInt32(&k.calls, 1) == 1 {
+		time.Sleep(k.delay)
+	}
+
+	return k.PrivateKey.SignDigest(h256)
+}
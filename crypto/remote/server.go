@@ -0,0 +1,270 @@
+// Copyright © 2020-2021 The EVEN Solutions Developers Team
+
+package remote
+
+import (
+	"crypto/hmac"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/platsko/go-kit/crypto"
+	"github.com/platsko/go-kit/crypto/remote/proto/pb"
+	"github.com/platsko/go-kit/errors"
+	"github.com/platsko/go-kit/timestamp"
+)
+
+const (
+	// DefaultTimeWindow defines the default max allowed difference
+	// between the request time and the server clock.
+	DefaultTimeWindow = 30 * time.Second
+)
+
+type (
+	// seenNonce represents the nonce of accepted request
+	// queued in order of acceptance to expire.
+	seenNonce struct {
+		nonce   string
+		expires time.Time
+	}
+
+	// Server serves signing requests with the private key it holds.
+	// Requests are authenticated with HMAC-SHA256 over the shared secret,
+	// stale and replayed requests are rejected.
+	Server struct {
+		prKey  crypto.PrivateKey
+		secret []byte
+		window time.Duration
+
+		mutex  sync.Mutex
+		nonces map[string]struct{}
+		queue  []seenNonce
+		conns  map[net.Conn]struct{}
+		lists  map[net.Listener]struct{}
+		closed bool
+	}
+)
+
+// NewServer constructs remote signer server over the private key
+// with the shared secret used to authenticate the requests.
+func NewServer(prKey crypto.PrivateKey, secret []byte) (*Server, error) {
+	if prKey == nil {
+		return nil, errors.ErrNilPointerValue()
+	}
+
+	if len(secret) < MinSecretSize {
+		return nil, ErrSecretTooShort()
+	}
+
+	return &Server{
+		prKey:  prKey,
+		secret: append([]byte(nil), secret...),
+		window: DefaultTimeWindow,
+		nonces: make(map[string]struct{}),
+		conns:  make(map[net.Conn]struct{}),
+		lists:  make(map[net.Listener]struct{}),
+	}, nil
+}
+
+// SetTimeWindow sets the max allowed difference
+// between the request time and the server clock.
+func (s *Server) SetTimeWindow(window time.Duration) {
+	s.mutex.Lock()
+	s.window = window
+	s.mutex.Unlock()
+}
+
+// Serve accepts connections on the listener and serves each of them
+// in a new goroutine. It blocks until the listener fails or server closed.
+func (s *Server) Serve(listener net.Listener) error {
+	if !s.track(listener, nil) {
+		_ = listener.Close()
+		return ErrServerClosed() // nolint: nlreturn
+	}
+	defer s.untrack(listener, nil)
+
+	for {
+		conn, err := listener.Accept()
+		if err != nil {
+			if s.isClosed() {
+				return ErrServerClosed()
+			}
+
+			return err
+		}
+
+		go s.ServeConn(conn)
+	}
+}
+
+// ServeConn serves requests on the single connection
+// until it is closed by the client or server.
+func (s *Server) ServeConn(conn net.Conn) {
+	if !s.track(nil, conn) {
+		_ = conn.Close()
+		return // nolint: nlreturn
+	}
+	defer s.untrack(nil, conn)
+
+	for {
+		req := pb.Request{}
+		if err := readFrame(conn, &req); err != nil {
+			return
+		}
+
+		resp, err := s.handle(&req)
+		if resp == nil {
+			resp = &pb.Response{Id: req.GetId()}
+		}
+		if err != nil {
+			resp.Error = err.Error()
+		}
+		resp.Mac = responseMAC(s.secret, req.GetNonce(), resp)
+
+		if writeFrame(conn, resp) != nil || errors.Is(err, ErrUnauthorized()) {
+			return // drop the connection of unauthenticated client
+		}
+	}
+}
+
+// Close closes all listeners and connections served by the server.
+func (s *Server) Close() error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.closed = true
+	for listener := range s.lists {
+		_ = listener.Close()
+	}
+	for conn := range s.conns {
+		_ = conn.Close()
+	}
+
+	return nil
+}
+
+// authenticate checks the request MAC, time and nonce.
+func (s *Server) authenticate(req *pb.Request) error {
+	if !hmac.Equal(req.GetMac(), requestMAC(s.secret, req)) {
+		return ErrUnauthorized()
+	}
+
+	if len(req.GetNonce()) != NonceSize || req.GetTime() == nil {
+		return ErrUnauthorized()
+	}
+
+	ts, err := timestamp.DecodeTimestamp(req.GetTime())
+	if err != nil {
+		return ErrUnauthorized()
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	now := time.Now()
+	if diff := now.Sub(ts.Time); diff > s.window || diff < -s.window {
+		return ErrStaleRequest()
+	}
+
+	s.expireNonces(now)
+
+	nonce := string(req.GetNonce())
+	if _, ok := s.nonces[nonce]; ok {
+		return ErrReplayedRequest()
+	}
+	s.nonces[nonce] = struct{}{}
+	s.queue = append(s.queue, seenNonce{nonce: nonce, expires: now.Add(2 * s.window)})
+
+	return nil
+}
+
+// expireNonces drops nonces outside of the time window they were accepted in,
+// the expiry is fixed on acceptance, so shrinking the window never evicts
+// the nonce while its request could still be replayed.
+// The queue is ordered by acceptance time, so only the expired head is visited,
+// entries queued behind the unexpired one are kept longer which is safe.
+// The caller must hold the mutex.
+func (s *Server) expireNonces(now time.Time) {
+	for len(s.queue) > 0 && now.After(s.queue[0].expires) {
+		delete(s.nonces, s.queue[0].nonce)
+		s.queue = s.queue[1:]
+	}
+}
+
+// handle authenticates and executes the request.
+func (s *Server) handle(req *pb.Request) (*pb.Response, error) {
+	if err := s.authenticate(req); err != nil {
+		return nil, err
+	}
+
+	resp := pb.Response{Id: req.GetId()}
+	switch req.GetMethod() {
+	case pb.Method_PUBLIC_KEY:
+		pbuf, err := s.prKey.PublicKey().Encode()
+		if err != nil {
+			return nil, err
+		}
+		resp.Pbkey = pbuf
+
+	case pb.Method_SIGN_DIGEST:
+		if len(req.GetDigest()) != crypto.Hash256Size {
+			return nil, ErrMessageMismatch()
+		}
+
+		h256 := crypto.Hash256{}
+		copy(h256[:], req.GetDigest())
+
+		sign, err := s.prKey.SignDigest(h256)
+		if err != nil {
+			return nil, err
+		}
+		resp.Sign = sign.Encode()
+
+	default:
+		return nil, ErrUnknownMethod()
+	}
+
+	return &resp, nil
+}
+
+// isClosed reports whether the server has been closed.
+func (s *Server) isClosed() bool {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	return s.closed
+}
+
+// track registers the listener or connection, it returns false if server closed.
+func (s *Server) track(listener net.Listener, conn net.Conn) bool {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.closed {
+		return false
+	}
+
+	if listener != nil {
+		s.lists[listener] = struct{}{}
+	}
+	if conn != nil {
+		s.conns[conn] = struct{}{}
+	}
+
+	return true
+}
+
+// untrack unregisters and closes the listener or connection.
+func (s *Server) untrack(listener net.Listener, conn net.Conn) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if listener != nil {
+		delete(s.lists, listener)
+		_ = listener.Close()
+	}
+	if conn != nil {
+		delete(s.conns, conn)
+		_ = conn.Close()
+	}
+}
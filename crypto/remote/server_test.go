@@ -0,0 +1,247 @@
+// Copyright © 2020-2021 The EVEN Solutions Developers Team
+
+package remote_test
+
+import (
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/platsko/go-kit/bytes"
+	"github.com/platsko/go-kit/crypto"
+	. "github.com/platsko/go-kit/crypto/remote"
+	"github.com/platsko/go-kit/crypto/remote/proto/pb"
+	"github.com/platsko/go-kit/errors"
+	"github.com/platsko/go-kit/timestamp"
+)
+
+func Test_NewServer(t *testing.T) {
+	t.Parallel()
+
+	tests := [3]struct {
+		name    string
+		prKey   crypto.PrivateKey
+		secret  []byte
+		wantErr bool
+	}{
+		{
+			name:   "OK",
+			prKey:  mockPrivateKey(crypto.Ed25519),
+			secret: mockSecret(),
+		},
+		{
+			name:    "nil_PrivateKey_ERR",
+			secret:  mockSecret(),
+			wantErr: true,
+		},
+		{
+			name:    "short_secret_ERR",
+			prKey:   mockPrivateKey(crypto.Ed25519),
+			secret:  bytes.RandBytes(MinSecretSize - 1),
+			wantErr: true,
+		},
+	}
+
+	for idx := range tests {
+		test := tests[idx]
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			if _, err := NewServer(test.prKey, test.secret); (err != nil) != test.wantErr {
+				t.Errorf("NewServer() error: %v | want: %v", err, test.wantErr)
+			}
+		})
+	}
+}
+
+func Test_Server_Close(t *testing.T) {
+	t.Parallel()
+
+	server, err := NewServer(mockPrivateKey(crypto.Ed25519), mockSecret())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	done := make(chan error, 1)
+	go func() { done <- server.Serve(mockListener(t, "tcp")) }()
+
+	time.Sleep(10 * time.Millisecond) // let the server start
+	if err = server.Close(); err != nil {
+		t.Fatalf("Close() error: %v | want: %v", err, false)
+	}
+
+	select {
+	case err = <-done:
+		if !errors.Is(err, ErrServerClosed()) {
+			t.Errorf("Serve() error: %v | want: %v", err, ErrServerClosed())
+		}
+	case <-time.After(time.Second):
+		t.Errorf("Serve() has not returned after Close()")
+	}
+
+	if err = server.Serve(mockListener(t, "tcp")); !errors.Is(err, ErrServerClosed()) {
+		t.Errorf("Serve() error: %v | want: %v", err, ErrServerClosed())
+	}
+}
+
+func Test_Server_ServeConn(t *testing.T) {
+	t.Parallel()
+
+	prKey, secret := mockPrivateKey(crypto.Ed25519), mockSecret()
+	request := func(ts timestamp.Timestamp, nonce []byte, method pb.Method, digest []byte) *pb.Request {
+		pbts, err := ts.Encode()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		req := pb.Request{Id: 1, Nonce: nonce, Time: pbts, Method: method, Digest: digest}
+		req.Mac = RequestMAC(secret, &req)
+
+		return &req
+	}
+
+	h256 := crypto.NewHash256(bytes.RandBytes(1024))
+	replayed := request(timestamp.Now(), bytes.RandBytes(NonceSize), pb.Method_SIGN_DIGEST, h256[:])
+	forged := request(timestamp.Now(), bytes.RandBytes(NonceSize), pb.Method_SIGN_DIGEST, h256[:])
+	forged.Digest = bytes.RandBytes(crypto.Hash256Size)
+
+	tests := [7]struct {
+		name    string
+		reqs    []*pb.Request
+		wantErr string
+	}{
+		{
+			name: "OK",
+			reqs: []*pb.Request{replayed},
+		},
+		{
+			name:    "replayed_ERR",
+			reqs:    []*pb.Request{replayed, replayed},
+			wantErr: ErrReplayedRequestMsg,
+		},
+		{
+			name:    "forged_ERR",
+			reqs:    []*pb.Request{forged},
+			wantErr: ErrUnauthorizedMsg,
+		},
+		{
+			name: "stale_ERR",
+			reqs: []*pb.Request{request(
+				timestamp.Timestamp{Time: time.Now().Add(-2 * DefaultTimeWindow)},
+				bytes.RandBytes(NonceSize), pb.Method_SIGN_DIGEST, h256[:],
+			)},
+			wantErr: ErrStaleRequestMsg,
+		},
+		{
+			name: "short_nonce_ERR",
+			reqs: []*pb.Request{request(
+				timestamp.Now(), bytes.RandBytes(NonceSize-1), pb.Method_SIGN_DIGEST, h256[:],
+			)},
+			wantErr: ErrUnauthorizedMsg,
+		},
+		{
+			name: "short_digest_ERR",
+			reqs: []*pb.Request{request(
+				timestamp.Now(), bytes.RandBytes(NonceSize), pb.Method_SIGN_DIGEST, h256[:8],
+			)},
+			wantErr: ErrMessageMismatchMsg,
+		},
+		{
+			name: "unknown_method_ERR",
+			reqs: []*pb.Request{request(
+				timestamp.Now(), bytes.RandBytes(NonceSize), pb.Method(-1), nil,
+			)},
+			wantErr: ErrUnknownMethodMsg,
+		},
+	}
+
+	for idx := range tests {
+		test := tests[idx]
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			server, err := NewServer(prKey, secret)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			cliConn, srvConn := net.Pipe()
+			go server.ServeConn(srvConn)
+			defer func() { _ = cliConn.Close() }()
+
+			resp := pb.Response{}
+			for _, req := range test.reqs {
+				if err = WriteFrame(cliConn, req); err != nil {
+					t.Fatal(err)
+				}
+				if err = ReadFrame(cliConn, &resp); err != nil {
+					t.Fatal(err)
+				}
+				if mac := ResponseMAC(secret, req.GetNonce(), &resp); string(mac) != string(resp.GetMac()) {
+					t.Errorf("ServeConn() got response mac: %x | want: %x", resp.GetMac(), mac)
+				}
+			}
+
+			if got := resp.GetError(); !strings.Contains(got, test.wantErr) || (test.wantErr == "") != (got == "") {
+				t.Errorf("ServeConn() error: %v | want: %v", got, test.wantErr)
+			}
+		})
+	}
+}
+
+func Test_Server_SetTimeWindow_Replay(t *testing.T) {
+	t.Parallel()
+
+	prKey, secret := mockPrivateKey(crypto.Ed25519), mockSecret()
+	server, err := NewServer(prKey, secret)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cliConn, srvConn := net.Pipe()
+	go server.ServeConn(srvConn)
+	defer func() { _ = cliConn.Close() }()
+
+	// the request from the future is accepted within the initial window
+	// and is replayed once it gets into the shrunk window
+	window, ahead := 50*time.Millisecond, 300*time.Millisecond
+	ts := timestamp.Timestamp{Time: time.Now().Add(ahead)}
+	pbts, err := ts.Encode()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	h256 := crypto.NewHash256(bytes.RandBytes(1024))
+	req := pb.Request{
+		Id:     1,
+		Nonce:  bytes.RandBytes(NonceSize),
+		Time:   pbts,
+		Method: pb.Method_SIGN_DIGEST,
+		Digest: h256[:],
+	}
+	req.Mac = RequestMAC(secret, &req)
+
+	send := func() string {
+		if err := WriteFrame(cliConn, &req); err != nil {
+			t.Fatal(err)
+		}
+		resp := pb.Response{}
+		if err := ReadFrame(cliConn, &resp); err != nil {
+			t.Fatal(err)
+		}
+
+		return resp.GetError()
+	}
+
+	if got := send(); got != "" {
+		t.Fatalf("ServeConn() error: %v | want: %v", got, nil)
+	}
+
+	server.SetTimeWindow(window)
+	time.Sleep(time.Until(ts.Time))
+
+	if got := send(); !strings.Contains(got, ErrReplayedRequestMsg) {
+		t.Errorf("ServeConn() error: %v | want: %v", got, ErrReplayedRequestMsg)
+	}
+}
@@ -0,0 +1,379 @@
+// Copyright © 2020-2021 The EVEN Solutions Developers Team
+
+package crypto
+
+import (
+	"time"
+
+	json "github.com/json-iterator/go"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/platsko/go-kit/crypto/proto/pb"
+	"github.com/platsko/go-kit/errors"
+	"github.com/platsko/go-kit/timestamp"
+)
+
+const (
+	// rotationCertDomain separates rotation certificate hashes
+	// from hashes of the other signable types.
+	rotationCertDomain = "kit.crypto.RotationCert"
+)
+
+type (
+	// RotationCert represents a certificate where the previous key
+	// signs the new key with the validity window of the link.
+	// The signature covers all fields of the certificate except itself.
+	RotationCert struct {
+		PbKey     PublicKey
+		NewKey    PublicKey
+		NotBefore timestamp.Timestamp
+		NotAfter  timestamp.Timestamp
+		Sign      Signature
+	}
+
+	// RotationChain represents ordered list of rotation certificates
+	// starting from the certificate signed by the root key.
+	RotationChain []*RotationCert
+)
+
+var (
+	// Make sure RotationCert implements Signable interface.
+	_ Signable = (*RotationCert)(nil)
+)
+
+// NewRotationCert makes the certificate of the new key valid
+// within the specified window and signs it with the previous key.
+func NewRotationCert(signer Signer, newKey PublicKey, notBefore, notAfter time.Time) (*RotationCert, error) {
+	if signer == nil {
+		return nil, errors.ErrNilPointerValue()
+	}
+
+	if newKey == nil {
+		return nil, ErrPublicKeyCannotBeNil()
+	}
+
+	if !notBefore.Before(notAfter) {
+		return nil, ErrInvalidValidity()
+	}
+
+	cert := RotationCert{
+		NewKey:    newKey,
+		NotBefore: timestamp.Timestamp{Time: notBefore},
+		NotAfter:  timestamp.Timestamp{Time: notAfter},
+	}
+
+	if _, err := signer.Sign(&cert); err != nil {
+		return nil, err
+	}
+
+	return &cert, nil
+}
+
+// DecodeRotationCert decodes a protobuf encoded message.
+func DecodeRotationCert(pbuf *pb.RotationCert) (*RotationCert, error) {
+	cert := RotationCert{}
+	if err := cert.Decode(pbuf); err != nil {
+		return nil, err
+	}
+
+	return &cert, nil
+}
+
+// Decode sets decoded data from protobuf message.
+func (c *RotationCert) Decode(pbuf *pb.RotationCert) error {
+	if pbuf == nil {
+		return errors.ErrNilPointerValue()
+	}
+
+	if pbuf.NotBefore == nil || pbuf.NotAfter == nil {
+		return ErrInvalidValidity()
+	}
+
+	notBefore, err := timestamp.DecodeTimestamp(pbuf.NotBefore)
+	if err != nil {
+		return err
+	}
+
+	notAfter, err := timestamp.DecodeTimestamp(pbuf.NotAfter)
+	if err != nil {
+		return err
+	}
+
+	var pbKey, newKey PublicKey
+	if pbuf.Pbkey != nil {
+		if pbKey, err = DecodePublicKey(pbuf.Pbkey); err != nil {
+			return err
+		}
+	}
+
+	if pbuf.NewKey != nil {
+		if newKey, err = DecodePublicKey(pbuf.NewKey); err != nil {
+			return err
+		}
+	}
+
+	var sign Signature
+	if pbuf.Sign != nil {
+		sign = DecodeSignature(pbuf.Sign)
+	}
+
+	c.PbKey = pbKey
+	c.NewKey = newKey
+	c.NotBefore = notBefore
+	c.NotAfter = notAfter
+	c.Sign = sign
+
+	return nil
+}
+
+// Encode converts data to protobuf message.
+func (c *RotationCert) Encode() (*pb.RotationCert, error) {
+	notBefore, err := c.NotBefore.Encode()
+	if err != nil {
+		return nil, err
+	}
+
+	notAfter, err := c.NotAfter.Encode()
+	if err != nil {
+		return nil, err
+	}
+
+	pbuf := pb.RotationCert{
+		NotBefore: notBefore,
+		NotAfter:  notAfter,
+	}
+
+	if c.PbKey != nil {
+		if pbuf.Pbkey, err = c.PbKey.Encode(); err != nil {
+			return nil, err
+		}
+	}
+
+	if c.NewKey != nil {
+		if pbuf.NewKey, err = c.NewKey.Encode(); err != nil {
+			return nil, err
+		}
+	}
+
+	if c.Sign != nil {
+		pbuf.Sign = c.Sign.Encode()
+	}
+
+	return &pbuf, nil
+}
+
+// GetSignature implements Signable.GetSignature method of interface.
+func (c *RotationCert) GetSignature() Signature {
+	return c.Sign
+}
+
+// Hash implements Hasher.Hash method of interface.
+// Hash calculates SHA256 checksum over length-prefixed fields
+// of the certificate including both keys, except the signature.
+func (c *RotationCert) Hash() (Hash256, error) {
+	notBefore, err := c.NotBefore.MarshalBinary()
+	if err != nil {
+		return Hash256{}, err
+	}
+
+	notAfter, err := c.NotAfter.MarshalBinary()
+	if err != nil {
+		return Hash256{}, err
+	}
+
+	var pbKey, newKey []byte
+	if c.PbKey != nil {
+		if pbKey, err = c.PbKey.Raw(); err != nil {
+			return Hash256{}, err
+		}
+	}
+
+	if c.NewKey != nil {
+		if newKey, err = c.NewKey.Raw(); err != nil {
+			return Hash256{}, err
+		}
+	}
+
+	return hashFields([]byte(rotationCertDomain), pbKey, newKey, notBefore, notAfter), nil
+}
+
+// Marshal implements marshaler interface for types
+// that can marshal themselves into bytes.
+func (c *RotationCert) Marshal() ([]byte, error) {
+	pbuf, err := c.Encode()
+	if err != nil {
+		return nil, err
+	}
+
+	return proto.Marshal(pbuf)
+}
+
+// MarshalJSON implements marshaler interface for types
+// that can marshal themselves into valid JSON.
+func (c *RotationCert) MarshalJSON() ([]byte, error) {
+	pbuf, err := c.Encode()
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(pbuf)
+}
+
+// SetPublicKey implements Signable.SetPublicKey method of interface.
+func (c *RotationCert) SetPublicKey(pbKey PublicKey) {
+	c.PbKey = pbKey
+}
+
+// SetSignature implements Signable.SetSignature method of interface.
+func (c *RotationCert) SetSignature(sign Signature) {
+	c.Sign = sign
+}
+
+// Unmarshal implements unmarshaler interface for types
+// that can unmarshal bytes of themselves.
+func (c *RotationCert) Unmarshal(b []byte) error {
+	pbuf := pb.RotationCert{}
+	if err := proto.Unmarshal(b, &pbuf); err != nil {
+		return err
+	}
+
+	return c.Decode(&pbuf)
+}
+
+// UnmarshalJSON implements unmarshaler interface for types
+// that can unmarshal a JSON description of themselves.
+func (c *RotationCert) UnmarshalJSON(b []byte) error {
+	pbuf := pb.RotationCert{}
+	if err := json.Unmarshal(b, &pbuf); err != nil {
+		return err
+	}
+
+	return c.Decode(&pbuf)
+}
+
+// ValidAt reports whether the time is within the certificate validity window.
+func (c *RotationCert) ValidAt(at time.Time) bool {
+	return !at.Before(c.NotBefore.Time) && at.Before(c.NotAfter.Time)
+}
+
+// Verify verifies the certificate signature by the previous key.
+func (c *RotationCert) Verify() error {
+	if c.PbKey == nil || c.NewKey == nil {
+		return ErrPublicKeyCannotBeNil()
+	}
+
+	ok, err := c.PbKey.Verify(c)
+	if err != nil {
+		return err
+	}
+
+	if !ok {
+		return ErrInvalidSignature()
+	}
+
+	return nil
+}
+
+// DecodeRotationChain decodes a protobuf encoded message.
+func DecodeRotationChain(pbuf *pb.RotationChain) (RotationChain, error) {
+	if pbuf == nil {
+		return nil, errors.ErrNilPointerValue()
+	}
+
+	chain := make(RotationChain, 0, len(pbuf.Certs))
+	for _, item := range pbuf.Certs {
+		cert, err := DecodeRotationCert(item)
+		if err != nil {
+			return nil, err
+		}
+		chain = append(chain, cert)
+	}
+
+	return chain, nil
+}
+
+// Encode converts data to protobuf message.
+func (c RotationChain) Encode() (*pb.RotationChain, error) {
+	pbuf := pb.RotationChain{Certs: make([]*pb.RotationCert, 0, len(c))}
+	for _, cert := range c {
+		if cert == nil {
+			return nil, errors.ErrNilPointerValue()
+		}
+
+		item, err := cert.Encode()
+		if err != nil {
+			return nil, err
+		}
+		pbuf.Certs = append(pbuf.Certs, item)
+	}
+
+	return &pbuf, nil
+}
+
+// Marshal implements marshaler interface for types
+// that can marshal themselves into bytes.
+func (c RotationChain) Marshal() ([]byte, error) {
+	pbuf, err := c.Encode()
+	if err != nil {
+		return nil, err
+	}
+
+	return proto.Marshal(pbuf)
+}
+
+// Unmarshal implements unmarshaler interface for types
+// that can unmarshal bytes of themselves.
+func (c *RotationChain) Unmarshal(b []byte) error {
+	pbuf := pb.RotationChain{}
+	if err := proto.Unmarshal(b, &pbuf); err != nil {
+		return err
+	}
+
+	chain, err := DecodeRotationChain(&pbuf)
+	if err != nil {
+		return err
+	}
+
+	*c = chain
+
+	return nil
+}
+
+// Verify walks the chain from the trusted root key and returns the current key.
+// Each certificate has to be signed by the key certified by the previous one
+// and has to begin within the validity window of the previous certificate,
+// so every rotation was made while the signing key was still trusted.
+// The last certificate has to be valid at the given time.
+// The root key is returned as it is for the empty chain.
+func (c RotationChain) Verify(root PublicKey, at time.Time) (PublicKey, error) {
+	if root == nil {
+		return nil, ErrPublicKeyCannotBeNil()
+	}
+
+	current, prev := root, (*RotationCert)(nil)
+	for _, cert := range c {
+		if cert == nil {
+			return nil, errors.ErrNilPointerValue()
+		}
+
+		if cert.PbKey == nil || !cert.PbKey.Equals(current) {
+			return nil, ErrRotationChainBroken()
+		}
+
+		if prev != nil && !prev.ValidAt(cert.NotBefore.Time) {
+			return nil, ErrRotationChainBroken()
+		}
+
+		if err := cert.Verify(); err != nil {
+			return nil, err
+		}
+
+		current, prev = cert.NewKey, cert
+	}
+
+	if prev != nil && !prev.ValidAt(at) {
+		return nil, ErrCertNotValid()
+	}
+
+	return current, nil
+}
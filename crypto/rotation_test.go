@@ -0,0 +1,355 @@
+// Copyright © 2020-2021 The EVEN Solutions Developers Team
+
+package crypto_test
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	. "github.com/platsko/go-kit/crypto"
+	"github.com/platsko/go-kit/crypto/proto/pb"
+	"github.com/platsko/go-kit/errors"
+)
+
+func mockRotationChain(size int, now time.Time) (PublicKey, RotationChain, []PrivateKey) {
+	prKey, root := mockGenerateKeyPair(Ed25519)
+	chain, keys := make(RotationChain, 0, size), []PrivateKey{prKey}
+	for i := 0; i < size; i++ {
+		next, _ := mockGenerateKeyPair(Ed25519)
+		notBefore := now.Add(time.Duration(i-size) * time.Hour)
+		cert, err := NewRotationCert(prKey, next.PublicKey(), notBefore, now.Add(time.Hour))
+		if err != nil {
+			panic(err)
+		}
+		chain, keys, prKey = append(chain, cert), append(keys, next), next
+	}
+
+	return root, chain, keys
+}
+
+func mockRotationCertBlob(strip func(*pb.RotationCert)) []byte {
+	_, chain, _ := mockRotationChain(1, time.Now())
+	pbuf, err := chain[0].Encode()
+	if err != nil {
+		panic(err)
+	}
+	strip(pbuf)
+
+	blob, err := proto.Marshal(pbuf)
+	if err != nil {
+		panic(err)
+	}
+
+	return blob
+}
+
+func Benchmark_NewRotationCert(b *testing.B) {
+	prKey, _ := mockGenerateKeyPair(Ed25519)
+	_, newKey := mockGenerateKeyPair(Ed25519)
+	now := time.Now()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := NewRotationCert(prKey, newKey, now, now.Add(time.Hour)); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func Benchmark_RotationChain_Verify(b *testing.B) {
+	now := time.Now()
+	root, chain, _ := mockRotationChain(4, now)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := chain.Verify(root, now); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func Test_NewRotationCert(t *testing.T) {
+	t.Parallel()
+
+	type (
+		testCase struct {
+			name      string
+			signer    Signer
+			newKey    PublicKey
+			notBefore time.Time
+			notAfter  time.Time
+			wantErr   error
+		}
+		testList []testCase
+	)
+
+	now := time.Now()
+	algos := GetAlgos()
+	tests := make(testList, 0, algos.Len()+4)
+	for name, algo := range algos {
+		prKey, _ := mockGenerateKeyPair(algo)
+		_, newKey := mockGenerateKeyPair(algo)
+		tests = append(tests, testCase{
+			name:      name + "_OK",
+			signer:    prKey,
+			newKey:    newKey,
+			notBefore: now,
+			notAfter:  now.Add(time.Hour),
+		})
+	}
+
+	prKey, newKey := mockGenerateKeyPair(Ed25519)
+	tests = append(tests, testCase{
+		name:      "nil_Signer_ERR",
+		newKey:    newKey,
+		notBefore: now,
+		notAfter:  now.Add(time.Hour),
+		wantErr:   errors.ErrNilPointerValue(),
+	}, testCase{
+		name:      "nil_PublicKey_ERR",
+		signer:    prKey,
+		notBefore: now,
+		notAfter:  now.Add(time.Hour),
+		wantErr:   ErrPublicKeyCannotBeNil(),
+	}, testCase{
+		name:      "empty_validity_ERR",
+		signer:    prKey,
+		newKey:    newKey,
+		notBefore: now,
+		notAfter:  now,
+		wantErr:   ErrInvalidValidity(),
+	}, testCase{
+		name:      "inverted_validity_ERR",
+		signer:    prKey,
+		newKey:    newKey,
+		notBefore: now.Add(time.Hour),
+		notAfter:  now,
+		wantErr:   ErrInvalidValidity(),
+	})
+
+	for idx := range tests {
+		test := tests[idx]
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			cert, err := NewRotationCert(test.signer, test.newKey, test.notBefore, test.notAfter)
+			if !errors.Is(err, test.wantErr) {
+				t.Errorf("NewRotationCert() error: %v | want: %v", err, test.wantErr)
+				return
+			}
+			if test.wantErr != nil {
+				return
+			}
+			if err = cert.Verify(); err != nil {
+				t.Errorf("Verify() error: %v | want: %v", err, nil)
+			}
+			if !cert.ValidAt(test.notBefore) || cert.ValidAt(test.notAfter) {
+				t.Errorf("ValidAt() got window: %v - %v", cert.NotBefore, cert.NotAfter)
+			}
+		})
+	}
+}
+
+func Test_RotationCert_Marshal(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	prKey, _ := mockGenerateKeyPair(Secp256k1)
+	_, newKey := mockGenerateKeyPair(Ed25519)
+	cert, err := NewRotationCert(prKey, newKey, now, now.Add(time.Hour))
+	if err != nil {
+		t.Fatalf("NewRotationCert() error: %v | want: %v", err, nil)
+	}
+
+	blob, err := cert.Marshal()
+	if err != nil {
+		t.Fatalf("Marshal() error: %v | want: %v", err, nil)
+	}
+
+	got := RotationCert{}
+	if err = got.Unmarshal(blob); err != nil {
+		t.Fatalf("Unmarshal() error: %v | want: %v", err, nil)
+	}
+	if err = got.Verify(); err != nil {
+		t.Errorf("Verify() error: %v | want: %v", err, nil)
+	}
+	if !got.NewKey.Equals(newKey) || !got.NotAfter.Equal(cert.NotAfter.Time) {
+		t.Errorf("Unmarshal() got: %#v | want: %#v", got, cert)
+	}
+
+	blob, err = cert.MarshalJSON()
+	if err != nil {
+		t.Fatalf("MarshalJSON() error: %v | want: %v", err, nil)
+	}
+
+	got = RotationCert{}
+	if err = got.UnmarshalJSON(blob); err != nil {
+		t.Fatalf("UnmarshalJSON() error: %v | want: %v", err, nil)
+	}
+	if err = got.Verify(); err != nil {
+		t.Errorf("Verify() error: %v | want: %v", err, nil)
+	}
+}
+
+func Test_RotationCert_Unmarshal_ERR(t *testing.T) {
+	t.Parallel()
+
+	tests := [3]struct {
+		name    string
+		blob    []byte
+		wantErr error
+	}{
+		{
+			name:    "empty_ERR",
+			blob:    []byte{},
+			wantErr: ErrInvalidValidity(),
+		},
+		{
+			name:    "no_not_before_ERR",
+			blob:    mockRotationCertBlob(func(pbuf *pb.RotationCert) { pbuf.NotBefore = nil }),
+			wantErr: ErrInvalidValidity(),
+		},
+		{
+			name:    "no_not_after_ERR",
+			blob:    mockRotationCertBlob(func(pbuf *pb.RotationCert) { pbuf.NotAfter = nil }),
+			wantErr: ErrInvalidValidity(),
+		},
+	}
+
+	for idx := range tests {
+		test := tests[idx]
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			if err := new(RotationCert).Unmarshal(test.blob); !errors.Is(err, test.wantErr) {
+				t.Errorf("Unmarshal() error: %v | want: %v", err, test.wantErr)
+			}
+		})
+	}
+}
+
+func Test_RotationChain_Marshal(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	root, chain, keys := mockRotationChain(3, now)
+
+	blob, err := chain.Marshal()
+	if err != nil {
+		t.Fatalf("Marshal() error: %v | want: %v", err, nil)
+	}
+
+	got := RotationChain{}
+	if err = got.Unmarshal(blob); err != nil {
+		t.Fatalf("Unmarshal() error: %v | want: %v", err, nil)
+	}
+
+	pbKey, err := got.Verify(root, now)
+	if err != nil {
+		t.Fatalf("Verify() error: %v | want: %v", err, nil)
+	}
+	if want := keys[len(keys)-1].PublicKey(); !pbKey.Equals(want) {
+		t.Errorf("Verify() got: %#v | want: %#v", pbKey, want)
+	}
+}
+
+func Test_RotationChain_Verify(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	root, chain, keys := mockRotationChain(3, now)
+	_, stranger := mockGenerateKeyPair(Ed25519)
+
+	reordered := RotationChain{chain[1], chain[0], chain[2]}
+
+	tampered := append(RotationChain{}, chain...)
+	forged := *chain[1]
+	forged.NewKey = stranger
+	tampered[1] = &forged
+
+	// the second rotation is made after the first key has already expired
+	prKey, late := mockGenerateKeyPair(Ed25519)
+	first, _ := NewRotationCert(keys[0], late, now.Add(-2*time.Hour), now.Add(-time.Hour))
+	second, _ := NewRotationCert(prKey, stranger, now.Add(-time.Minute), now.Add(time.Hour))
+
+	tests := [8]struct {
+		name    string
+		root    PublicKey
+		chain   RotationChain
+		at      time.Time
+		want    PublicKey
+		wantErr error
+	}{
+		{
+			name:  "OK",
+			root:  root,
+			chain: chain,
+			at:    now,
+			want:  keys[len(keys)-1].PublicKey(),
+		},
+		{
+			name: "empty_chain_OK",
+			root: root,
+			at:   now,
+			want: root,
+		},
+		{
+			name:    "nil_root_ERR",
+			chain:   chain,
+			at:      now,
+			wantErr: ErrPublicKeyCannotBeNil(),
+		},
+		{
+			name:    "untrusted_root_ERR",
+			root:    stranger,
+			chain:   chain,
+			at:      now,
+			wantErr: ErrRotationChainBroken(),
+		},
+		{
+			name:    "reordered_ERR",
+			root:    root,
+			chain:   reordered,
+			at:      now,
+			wantErr: ErrRotationChainBroken(),
+		},
+		{
+			name:    "tampered_ERR",
+			root:    root,
+			chain:   tampered,
+			at:      now,
+			wantErr: ErrInvalidSignature(),
+		},
+		{
+			name:    "expired_link_ERR",
+			root:    root,
+			chain:   RotationChain{first, second},
+			at:      now,
+			wantErr: ErrRotationChainBroken(),
+		},
+		{
+			name:    "expired_ERR",
+			root:    root,
+			chain:   chain,
+			at:      now.Add(time.Hour),
+			wantErr: ErrCertNotValid(),
+		},
+	}
+
+	for idx := range tests {
+		test := tests[idx]
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := test.chain.Verify(test.root, test.at)
+			if !errors.Is(err, test.wantErr) {
+				t.Errorf("Verify() error: %v | want: %v", err, test.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("Verify() got: %#v | want: %#v", got, test.want)
+			}
+		})
+	}
+}
@@ -20,8 +20,31 @@ type (
 	}
 
 	// Signer represents interface for signing signable objects.
+	//
+	// Sign sets the signer public key to the signable object before
+	// the hash is calculated, so the public key is covered by the signature
+	// when the signable hash func includes it. The calculated signature
+	// is set to the signable object after signing.
 	Signer interface {
 		// Sign signs signable object.
 		Sign(Signable) (Signature, error)
 	}
+
+	// HashSigner represents interface for signing objects without mutating them.
+	//
+	// The hash is calculated over the object as it is, so the public key
+	// is covered by the signature only when the caller has already set it
+	// and the object hash func includes it. It is safe to sign the same
+	// object from several goroutines as long as its hash func is read only.
+	HashSigner interface {
+		// PublicKey returns the public key paired with the signer.
+		PublicKey() PublicKey
+
+		// SignDigest signs the given hash checksum.
+		SignDigest(Hash256) (Signature, error)
+
+		// SignHasher signs the hash of the hasher object and
+		// returns the signature with the public key of the signer.
+		SignHasher(Hasher) (Signature, PublicKey, error)
+	}
 )
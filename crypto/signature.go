@@ -40,6 +40,9 @@ type (
 		// that can converts themselves to string format.
 		String() string
 
+		// Text encodes a signature to string with the text encoding.
+		Text(TextEncoding) (string, error)
+
 		// Unmarshal implements unmarshaler interface for types
 		// that can unmarshal bytes of themselves.
 		Unmarshal(b []byte) error
@@ -70,6 +73,20 @@ func DecodeSignature(pbuf *pb.Signature) Signature {
 	return &signature{blob: pbuf.Blob}
 }
 
+// ParseSignatureText decodes the string to Signature with the text encoding.
+func ParseSignatureText(enc TextEncoding, s string) (Signature, error) {
+	if !enc.IsValid() {
+		return nil, ErrUnknownTextEncoding()
+	}
+
+	blob, err := enc.DecodeString(s)
+	if err != nil {
+		return nil, ErrInvalidSignature()
+	}
+
+	return NewSignature(blob), nil
+}
+
 // Decode implements Signature.Decode method of interface.
 func (c *signature) Decode(pbuf *pb.Signature) {
 	c.blob = pbuf.Blob
@@ -120,6 +137,11 @@ func (c *signature) String() string {
 	return hex.EncodeToString(c.blob)
 }
 
+// Text implements Signature.Text method of interface.
+func (c *signature) Text(enc TextEncoding) (string, error) {
+	return encodeTextRaw(enc, c.Raw)
+}
+
 // Unmarshal implements Signature.Unmarshal method of interface.
 func (c *signature) Unmarshal(b []byte) error {
 	pbuf := pb.Signature{}
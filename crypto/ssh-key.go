@@ -0,0 +1,484 @@
+// Copyright © 2020-2021 The EVEN Solutions Developers Team
+
+package crypto
+
+import (
+	"bytes"
+	gocrypto "crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/binary"
+	"encoding/pem"
+	"math/big"
+	"strings"
+
+	xed25519 "golang.org/x/crypto/ed25519"
+	"golang.org/x/crypto/ssh"
+
+	"github.com/platsko/go-kit/errors"
+)
+
+const (
+	// SSHPrivateKeyType is PEM block type of OpenSSH private key.
+	SSHPrivateKeyType = "OPENSSH PRIVATE KEY"
+
+	// SSHSignatureType is PEM block type of SSH signature.
+	SSHSignatureType = "SSH SIGNATURE"
+
+	// sshPrivateKeyMagic is a magic prefix of OpenSSH private key.
+	sshPrivateKeyMagic = "openssh-key-v1\x00"
+
+	// sshPrivateKeyBlockSize is a padding block size of unencrypted private key.
+	sshPrivateKeyBlockSize = 8
+
+	// sshSigMagic is a magic prefix of SSH signature.
+	sshSigMagic = "SSHSIG"
+
+	// sshSigVersion is a supported version of SSH signature.
+	sshSigVersion = 1
+)
+
+type (
+	// sshPrivateKeyHeader describes OpenSSH private key container.
+	sshPrivateKeyHeader struct {
+		CipherName   string
+		KdfName      string
+		KdfOpts      string
+		NumKeys      uint32
+		PubKey       []byte
+		PrivKeyBlock []byte
+	}
+
+	// sshPrivateKeyBlock describes OpenSSH private key section.
+	sshPrivateKeyBlock struct {
+		Check1  uint32
+		Check2  uint32
+		KeyType string
+		Rest    []byte `ssh:"rest"`
+	}
+
+	// sshEd25519PrivateKey describes Ed25519 private key fields.
+	sshEd25519PrivateKey struct {
+		Pub     []byte
+		Priv    []byte
+		Comment string
+		Pad     []byte `ssh:"rest"`
+	}
+
+	// sshECDSAPrivateKey describes ECDSA private key fields.
+	sshECDSAPrivateKey struct {
+		Curve   string
+		Pub     []byte
+		D       *big.Int
+		Comment string
+		Pad     []byte `ssh:"rest"`
+	}
+
+	// sshRSAPrivateKey describes RSA private key fields.
+	sshRSAPrivateKey struct {
+		N       *big.Int
+		E       *big.Int
+		D       *big.Int
+		Iqmp    *big.Int
+		P       *big.Int
+		Q       *big.Int
+		Comment string
+		Pad     []byte `ssh:"rest"`
+	}
+
+	// sshSig describes SSH signature blob.
+	sshSig struct {
+		Magic         [6]byte
+		Version       uint32
+		PublicKey     []byte
+		Namespace     string
+		Reserved      []byte
+		HashAlgorithm string
+		Signature     []byte
+	}
+
+	// sshSigSignedData describes the data signed by SSH signature.
+	sshSigSignedData struct {
+		Magic         [6]byte
+		Namespace     string
+		Reserved      []byte
+		HashAlgorithm string
+		Hash          []byte
+	}
+)
+
+var (
+	// sshCurves maps OpenSSH curve names to elliptic curves.
+	sshCurves = map[string]elliptic.Curve{
+		"nistp256": elliptic.P256(),
+		"nistp384": elliptic.P384(),
+		"nistp521": elliptic.P521(),
+	}
+)
+
+// ParseAuthorizedKey parses the public key from OpenSSH authorized_keys line
+// and returns it with the comment, the options of the line are ignored.
+func ParseAuthorizedKey(line []byte) (PublicKey, string, error) {
+	key, comment, _, _, err := ssh.ParseAuthorizedKey(line)
+	if err != nil {
+		return nil, "", err
+	}
+
+	pbKey, err := publicKeyFromSSH(key)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return pbKey, comment, nil
+}
+
+// MarshalAuthorizedKey returns OpenSSH authorized_keys line of the public key
+// without trailing newline, the comment is appended if it is not empty.
+func MarshalAuthorizedKey(pbKey PublicKey, comment string) ([]byte, error) {
+	key, err := sshPublicKey(pbKey)
+	if err != nil {
+		return nil, err
+	}
+
+	line := bytes.TrimSuffix(ssh.MarshalAuthorizedKey(key), []byte("\n"))
+	if comment != "" {
+		line = append(line, ' ')
+		line = append(line, comment...)
+	}
+
+	return line, nil
+}
+
+// ParseSSHPrivateKey parses PEM encoded OpenSSH private key
+// and returns it with the comment, encrypted keys are not supported.
+func ParseSSHPrivateKey(pemBytes []byte) (PrivateKey, string, error) {
+	block, _ := pem.Decode(pemBytes)
+	if block == nil || block.Type != SSHPrivateKeyType {
+		return nil, "", ErrInvalidSSHKey()
+	}
+
+	blob := block.Bytes
+	if !bytes.HasPrefix(blob, []byte(sshPrivateKeyMagic)) {
+		return nil, "", ErrInvalidSSHKey()
+	}
+
+	header := sshPrivateKeyHeader{}
+	if err := ssh.Unmarshal(blob[len(sshPrivateKeyMagic):], &header); err != nil {
+		return nil, "", err
+	}
+
+	if header.CipherName != "none" || header.KdfName != "none" {
+		return nil, "", ErrEncryptedSSHKey()
+	}
+
+	if header.NumKeys != 1 {
+		return nil, "", ErrInvalidSSHKey()
+	}
+
+	priv := sshPrivateKeyBlock{}
+	if err := ssh.Unmarshal(header.PrivKeyBlock, &priv); err != nil {
+		return nil, "", err
+	}
+
+	if priv.Check1 != priv.Check2 {
+		return nil, "", ErrInvalidSSHKey()
+	}
+
+	key, comment, pad, err := parseSSHPrivateKeyFields(priv.KeyType, priv.Rest)
+	if err != nil {
+		return nil, "", err
+	}
+
+	for i, b := range pad {
+		if int(b) != i+1 {
+			return nil, "", ErrInvalidSSHKey()
+		}
+	}
+
+	prKey, err := PrivateKeyFromStd(key)
+	if err != nil {
+		return nil, "", err
+	}
+
+	// the public key of the container must be the one of the private key
+	pbKey, err := sshPublicKey(prKey.PublicKey())
+	if err != nil {
+		return nil, "", err
+	}
+	if !bytes.Equal(header.PubKey, pbKey.Marshal()) {
+		return nil, "", ErrInvalidSSHKey()
+	}
+
+	return prKey, comment, nil
+}
+
+// MarshalSSHPrivateKey returns PEM encoded unencrypted OpenSSH private key.
+func MarshalSSHPrivateKey(prKey PrivateKey, comment string) ([]byte, error) {
+	if prKey == nil {
+		return nil, errors.ErrNilPointerValue()
+	}
+
+	if prKey.Algo() == Secp256k1 {
+		return nil, ErrUnsupportedKeyType()
+	}
+
+	pbKey, err := sshPublicKey(prKey.PublicKey())
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := PrivateKeyToStd(prKey)
+	if err != nil {
+		return nil, err
+	}
+
+	var fields []byte
+	switch std := key.(type) {
+	case ed25519.PrivateKey:
+		fields = ssh.Marshal(sshEd25519PrivateKey{
+			Pub:     std.Public().(ed25519.PublicKey),
+			Priv:    std,
+			Comment: comment,
+		})
+
+	case *ecdsa.PrivateKey:
+		fields = ssh.Marshal(sshECDSAPrivateKey{
+			Curve:   strings.TrimPrefix(pbKey.Type(), "ecdsa-sha2-"),
+			Pub:     elliptic.Marshal(std.Curve, std.X, std.Y),
+			D:       std.D,
+			Comment: comment,
+		})
+
+	case *rsa.PrivateKey:
+		if len(std.Primes) != 2 {
+			return nil, ErrUnsupportedKeyType()
+		}
+		std.Precompute()
+		fields = ssh.Marshal(sshRSAPrivateKey{
+			N:       std.N,
+			E:       big.NewInt(int64(std.E)),
+			D:       std.D,
+			Iqmp:    std.Precomputed.Qinv,
+			P:       std.Primes[0],
+			Q:       std.Primes[1],
+			Comment: comment,
+		})
+
+	default:
+		return nil, ErrUnsupportedKeyType()
+	}
+
+	check := [4]byte{}
+	if _, err = rand.Read(check[:]); err != nil {
+		return nil, err
+	}
+
+	priv := sshPrivateKeyBlock{
+		Check1:  binary.BigEndian.Uint32(check[:]),
+		Check2:  binary.BigEndian.Uint32(check[:]),
+		KeyType: pbKey.Type(),
+		Rest:    fields,
+	}
+
+	block := ssh.Marshal(priv)
+	for i := 1; len(block)%sshPrivateKeyBlockSize != 0; i++ {
+		block = append(block, byte(i))
+	}
+
+	blob := append([]byte(sshPrivateKeyMagic), ssh.Marshal(sshPrivateKeyHeader{
+		CipherName:   "none",
+		KdfName:      "none",
+		NumKeys:      1,
+		PubKey:       pbKey.Marshal(),
+		PrivKeyBlock: block,
+	})...)
+
+	return pem.EncodeToMemory(&pem.Block{Type: SSHPrivateKeyType, Bytes: blob}), nil
+}
+
+// VerifySSHSig verifies armored SSH signature made with "ssh-keygen -Y sign"
+// over the message within the namespace by the public key.
+func VerifySSHSig(pbKey PublicKey, namespace string, message, armored []byte) (bool, error) {
+	key, err := sshPublicKey(pbKey)
+	if err != nil {
+		return false, err
+	}
+
+	block, _ := pem.Decode(armored)
+	if block == nil || block.Type != SSHSignatureType {
+		return false, ErrInvalidSSHSignature()
+	}
+
+	sig := sshSig{}
+	if err = ssh.Unmarshal(block.Bytes, &sig); err != nil {
+		return false, err
+	}
+
+	if string(sig.Magic[:]) != sshSigMagic || sig.Version != sshSigVersion {
+		return false, ErrInvalidSSHSignature()
+	}
+
+	if sig.Namespace != namespace {
+		return false, ErrNamespaceMismatch()
+	}
+
+	if !bytes.Equal(sig.PublicKey, key.Marshal()) {
+		return false, nil
+	}
+
+	var hash gocrypto.Hash
+	switch sig.HashAlgorithm {
+	case "sha256":
+		hash = gocrypto.SHA256
+	case "sha512":
+		hash = gocrypto.SHA512
+	default:
+		return false, ErrInvalidSSHSignature()
+	}
+
+	signature := ssh.Signature{}
+	if err = ssh.Unmarshal(sig.Signature, &signature); err != nil {
+		return false, err
+	}
+
+	// sshsig does not allow SHA-1 based RSA signatures
+	if signature.Format == ssh.SigAlgoRSA {
+		return false, ErrInvalidSSHSignature()
+	}
+
+	data := sshSigSignedData{
+		Namespace:     sig.Namespace,
+		Reserved:      sig.Reserved,
+		HashAlgorithm: sig.HashAlgorithm,
+		Hash:          sshSigHash(hash, message),
+	}
+	copy(data.Magic[:], sshSigMagic)
+
+	return key.Verify(ssh.Marshal(data), &signature) == nil, nil
+}
+
+// sshSigHash calculates the message hash with the SSH signature hash algo.
+func sshSigHash(hash gocrypto.Hash, message []byte) []byte {
+	if hash == gocrypto.SHA512 {
+		sum := sha512.Sum512(message)
+		return sum[:]
+	}
+
+	sum := sha256.Sum256(message)
+
+	return sum[:]
+}
+
+// parseSSHPrivateKeyFields parses key specific fields of OpenSSH private key
+// and returns the standard library private key, the comment and the padding.
+func parseSSHPrivateKeyFields(keyType string, blob []byte) (gocrypto.PrivateKey, string, []byte, error) {
+	switch keyType {
+	case ssh.KeyAlgoED25519:
+		key := sshEd25519PrivateKey{}
+		if err := ssh.Unmarshal(blob, &key); err != nil {
+			return nil, "", nil, err
+		}
+		if len(key.Priv) != ed25519.PrivateKeySize {
+			return nil, "", nil, ErrInvalidSSHKey()
+		}
+
+		// the public half is stored twice and must be derived from the seed
+		std := ed25519.NewKeyFromSeed(key.Priv[:ed25519.SeedSize])
+		if !bytes.Equal(std, key.Priv) || !bytes.Equal(key.Pub, std[ed25519.SeedSize:]) {
+			return nil, "", nil, ErrInvalidSSHKey()
+		}
+
+		return std, key.Comment, key.Pad, nil
+
+	case ssh.KeyAlgoECDSA256, ssh.KeyAlgoECDSA384, ssh.KeyAlgoECDSA521:
+		key := sshECDSAPrivateKey{}
+		if err := ssh.Unmarshal(blob, &key); err != nil {
+			return nil, "", nil, err
+		}
+
+		curve, ok := sshCurves[key.Curve]
+		if !ok || keyType != "ecdsa-sha2-"+key.Curve {
+			return nil, "", nil, ErrInvalidSSHKey()
+		}
+
+		x, y := elliptic.Unmarshal(curve, key.Pub)
+		if x == nil || key.D.Sign() <= 0 || key.D.Cmp(curve.Params().N) >= 0 {
+			return nil, "", nil, ErrInvalidSSHKey()
+		}
+
+		// the public point must be D·G
+		if gx, gy := curve.ScalarBaseMult(key.D.Bytes()); gx.Cmp(x) != 0 || gy.Cmp(y) != 0 {
+			return nil, "", nil, ErrInvalidSSHKey()
+		}
+
+		std := ecdsa.PrivateKey{
+			PublicKey: ecdsa.PublicKey{Curve: curve, X: x, Y: y},
+			D:         key.D,
+		}
+
+		return &std, key.Comment, key.Pad, nil
+
+	case ssh.KeyAlgoRSA:
+		key := sshRSAPrivateKey{}
+		if err := ssh.Unmarshal(blob, &key); err != nil {
+			return nil, "", nil, err
+		}
+
+		std := rsa.PrivateKey{
+			PublicKey: rsa.PublicKey{N: key.N, E: int(key.E.Int64())},
+			D:         key.D,
+			Primes:    []*big.Int{key.P, key.Q},
+		}
+		if err := std.Validate(); err != nil {
+			return nil, "", nil, err
+		}
+		std.Precompute()
+
+		return &std, key.Comment, key.Pad, nil
+	}
+
+	return nil, "", nil, ErrUnsupportedKeyType()
+}
+
+// publicKeyFromSSH converts ssh.PublicKey to PublicKey.
+func publicKeyFromSSH(key ssh.PublicKey) (PublicKey, error) {
+	ki, ok := key.(ssh.CryptoPublicKey)
+	if !ok {
+		return nil, ErrUnsupportedKeyType()
+	}
+
+	std := ki.CryptoPublicKey()
+	if xki, ok := std.(xed25519.PublicKey); ok {
+		std = ed25519.PublicKey(xki)
+	}
+
+	return PublicKeyFromStd(std)
+}
+
+// sshPublicKey converts the public key to ssh.PublicKey,
+// Secp256k1 public keys are not supported by SSH.
+func sshPublicKey(pbKey PublicKey) (ssh.PublicKey, error) {
+	if pbKey == nil {
+		return nil, ErrPublicKeyCannotBeNil()
+	}
+
+	if pbKey.Algo() == Secp256k1 {
+		return nil, ErrUnsupportedKeyType()
+	}
+
+	key, err := PublicKeyToStd(pbKey)
+	if err != nil {
+		return nil, err
+	}
+
+	if ki, ok := key.(ed25519.PublicKey); ok {
+		key = xed25519.PublicKey(ki)
+	}
+
+	return ssh.NewPublicKey(key)
+}
@@ -0,0 +1,143 @@
+// Copyright © 2020-2021 The EVEN Solutions Developers Team
+
+package crypto
+
+import (
+	gocrypto "crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/x509"
+
+	"github.com/btcsuite/btcd/btcec"
+	cc "github.com/libp2p/go-libp2p-core/crypto"
+
+	"github.com/platsko/go-kit/errors"
+)
+
+// PublicKeyToStd converts the public key to the standard library type:
+// ed25519.PublicKey, *ecdsa.PublicKey or *rsa.PublicKey.
+// Secp256k1 public key is converted to *ecdsa.PublicKey over btcec.S256 curve.
+func PublicKeyToStd(pbKey PublicKey) (gocrypto.PublicKey, error) {
+	if pbKey == nil {
+		return nil, ErrPublicKeyCannotBeNil()
+	}
+
+	raw, err := pbKey.Raw()
+	if err != nil {
+		return nil, err
+	}
+
+	switch pbKey.Algo() {
+	case Ed25519:
+		return ed25519.PublicKey(raw), nil
+
+	case Secp256k1:
+		ki, err := btcec.ParsePubKey(raw, btcec.S256())
+		if err != nil {
+			return nil, err
+		}
+
+		return ki.ToECDSA(), nil
+
+	case ECDSA, RSA:
+		return x509.ParsePKIXPublicKey(raw)
+
+	default:
+		return nil, ErrUnsupportedKeyType()
+	}
+}
+
+// PublicKeyFromStd converts the standard library public key type to PublicKey.
+// *ecdsa.PublicKey over btcec.S256 curve is converted to Secp256k1 public key.
+func PublicKeyFromStd(key gocrypto.PublicKey) (PublicKey, error) {
+	var (
+		ki  cc.PubKey
+		err error
+	)
+
+	switch std := key.(type) {
+	case ed25519.PublicKey:
+		ki, err = cc.UnmarshalEd25519PublicKey(std)
+
+	case *ecdsa.PublicKey:
+		if std.Curve == btcec.S256() {
+			ki, err = cc.UnmarshalSecp256k1PublicKey((*btcec.PublicKey)(std).SerializeCompressed())
+			break
+		}
+
+		ki, err = unmarshalPKIX(std, cc.UnmarshalECDSAPublicKey)
+
+	case *rsa.PublicKey:
+		ki, err = unmarshalPKIX(std, cc.UnmarshalRsaPublicKey)
+
+	default:
+		return nil, ErrUnsupportedKeyType()
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return NewPublicKey(ki), nil
+}
+
+// unmarshalPKIX converts the public key via PKIX encoding.
+func unmarshalPKIX(key gocrypto.PublicKey, unmarshal cc.PubKeyUnmarshaller) (cc.PubKey, error) {
+	blob, err := x509.MarshalPKIXPublicKey(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return unmarshal(blob)
+}
+
+// PrivateKeyToStd converts the private key to the standard library type:
+// ed25519.PrivateKey, *ecdsa.PrivateKey or *rsa.PrivateKey.
+// Secp256k1 private key is converted to *ecdsa.PrivateKey over btcec.S256 curve.
+func PrivateKeyToStd(prKey PrivateKey) (gocrypto.PrivateKey, error) {
+	if prKey == nil || prKey.Libp2pKey() == nil {
+		return nil, errors.ErrNilPointerValue()
+	}
+
+	key, err := cc.PrivKeyToStdKey(prKey.Libp2pKey())
+	if err != nil {
+		return nil, err
+	}
+
+	switch std := key.(type) {
+	case *ed25519.PrivateKey:
+		return *std, nil
+
+	case *cc.Secp256k1PrivateKey:
+		return (*btcec.PrivateKey)(std).ToECDSA(), nil
+	}
+
+	return key, nil
+}
+
+// PrivateKeyFromStd converts the standard library private key type to PrivateKey.
+// *ecdsa.PrivateKey over btcec.S256 curve is converted to Secp256k1 private key.
+func PrivateKeyFromStd(key gocrypto.PrivateKey) (PrivateKey, error) {
+	switch std := key.(type) {
+	case ed25519.PrivateKey:
+		key = &std
+
+	case *ecdsa.PrivateKey:
+		if std.Curve == btcec.S256() {
+			key = (*btcec.PrivateKey)(std)
+		}
+
+	case *ed25519.PrivateKey, *rsa.PrivateKey:
+
+	default:
+		return nil, ErrUnsupportedKeyType()
+	}
+
+	ki, _, err := cc.KeyPairFromStdKey(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewPrivateKey(ki), nil
+}
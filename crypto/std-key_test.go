@@ -0,0 +1,130 @@
+// Copyright © 2020-2021 The EVEN Solutions Developers Team
+
+package crypto_test
+
+import (
+	"testing"
+
+	. "github.com/platsko/go-kit/crypto"
+)
+
+func Test_PublicKeyToStd(t *testing.T) {
+	t.Parallel()
+
+	type (
+		testCase struct {
+			name    string
+			pbKey   PublicKey
+			wantErr bool
+		}
+		testList []testCase
+	)
+
+	algos := GetAlgos()
+	tests := make(testList, 0, algos.Len()+1)
+	for name, algo := range algos {
+		_, pbKey := mockGenerateKeyPair(algo)
+		tests = append(tests, testCase{
+			name:  name + "_OK",
+			pbKey: pbKey,
+		})
+	}
+	tests = append(tests, testCase{
+		name:    "nil_PublicKey_ERR",
+		wantErr: true,
+	})
+
+	for idx := range tests {
+		test := tests[idx]
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			key, err := PublicKeyToStd(test.pbKey)
+			if (err != nil) != test.wantErr {
+				t.Errorf("PublicKeyToStd() error: %v | want: %v", err, test.wantErr)
+				return
+			}
+			if test.wantErr {
+				return
+			}
+
+			got, err := PublicKeyFromStd(key)
+			if err != nil {
+				t.Errorf("PublicKeyFromStd() error: %v | want: %v", err, false)
+				return
+			}
+			if !got.Equals(test.pbKey) {
+				t.Errorf("PublicKeyFromStd() got: %v | want: %v", got, test.pbKey)
+			}
+		})
+	}
+}
+
+func Test_PublicKeyFromStd_ERR(t *testing.T) {
+	t.Parallel()
+
+	if _, err := PublicKeyFromStd("unsupported"); err == nil {
+		t.Errorf("PublicKeyFromStd() error: %v | want: %v", err, true)
+	}
+}
+
+func Test_PrivateKeyToStd(t *testing.T) {
+	t.Parallel()
+
+	type (
+		testCase struct {
+			name    string
+			prKey   PrivateKey
+			wantErr bool
+		}
+		testList []testCase
+	)
+
+	algos := GetAlgos()
+	tests := make(testList, 0, algos.Len()+1)
+	for name, algo := range algos {
+		prKey, _ := mockGenerateKeyPair(algo)
+		tests = append(tests, testCase{
+			name:  name + "_OK",
+			prKey: prKey,
+		})
+	}
+	tests = append(tests, testCase{
+		name:    "nil_PrivateKey_ERR",
+		prKey:   NewPrivateKey(nil),
+		wantErr: true,
+	})
+
+	for idx := range tests {
+		test := tests[idx]
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			key, err := PrivateKeyToStd(test.prKey)
+			if (err != nil) != test.wantErr {
+				t.Errorf("PrivateKeyToStd() error: %v | want: %v", err, test.wantErr)
+				return
+			}
+			if test.wantErr {
+				return
+			}
+
+			got, err := PrivateKeyFromStd(key)
+			if err != nil {
+				t.Errorf("PrivateKeyFromStd() error: %v | want: %v", err, false)
+				return
+			}
+			if got.Algo() != test.prKey.Algo() || !got.PublicKey().Equals(test.prKey.PublicKey()) {
+				t.Errorf("PrivateKeyFromStd() got: %v | want: %v", got.PublicKey(), test.prKey.PublicKey())
+			}
+		})
+	}
+}
+
+func Test_PrivateKeyFromStd_ERR(t *testing.T) {
+	t.Parallel()
+
+	if _, err := PrivateKeyFromStd("unsupported"); err == nil {
+		t.Errorf("PrivateKeyFromStd() error: %v | want: %v", err, true)
+	}
+}
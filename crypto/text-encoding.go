@@ -0,0 +1,143 @@
+// Copyright © 2020-2021 The EVEN Solutions Developers Team
+
+package crypto
+
+import (
+	"encoding/base32"
+	"encoding/base64"
+	"encoding/hex"
+	"strings"
+
+	"github.com/platsko/go-kit/base58"
+)
+
+const (
+	// HexEncoding is lower case hex encoding,
+	// upper case is accepted on decoding.
+	HexEncoding TextEncoding = iota + 1
+
+	// Base32Encoding is lower case RFC 4648 base32 encoding without padding,
+	// upper case is accepted on decoding.
+	Base32Encoding
+
+	// Base58Encoding is base58 encoding with the Bitcoin alphabet.
+	Base58Encoding
+
+	// Base64Encoding is RFC 4648 standard base64 encoding with padding.
+	Base64Encoding
+)
+
+type (
+	// TextEncoding represents textual encoding
+	// shared by the byte-like types of the package.
+	// Every type encodes itself with the Text method, hashes never fail
+	// to encode and also provide the helper method per encoding.
+	TextEncoding int
+)
+
+var (
+	// base32Lower is RFC 4648 base32 encoding with lower case alphabet.
+	base32Lower = base32.NewEncoding("abcdefghijklmnopqrstuvwxyz234567").WithPadding(base32.NoPadding)
+
+	// textEncodings maps supported text encodings to its names and codecs.
+	textEncodings = map[TextEncoding]struct {
+		name   string
+		encode func([]byte) string
+		decode func(string) ([]byte, error)
+	}{
+		HexEncoding:    {name: "hex", encode: hex.EncodeToString, decode: hex.DecodeString},
+		Base32Encoding: {name: "base32", encode: base32Lower.EncodeToString, decode: decodeBase32},
+		Base58Encoding: {name: "base58", encode: base58.EncodeToString, decode: base58.DecodeString},
+		Base64Encoding: {name: "base64", encode: base64.StdEncoding.EncodeToString, decode: base64.StdEncoding.DecodeString},
+	}
+)
+
+// ParseTextEncoding returns the text encoding by its name.
+func ParseTextEncoding(name string) (TextEncoding, error) {
+	name = strings.ToLower(name)
+	for enc, codec := range textEncodings {
+		if codec.name == name {
+			return enc, nil
+		}
+	}
+
+	return 0, ErrUnknownTextEncoding()
+}
+
+// DecodeString decodes the string to bytes.
+func (c TextEncoding) DecodeString(s string) ([]byte, error) {
+	codec, ok := textEncodings[c]
+	if !ok {
+		return nil, ErrUnknownTextEncoding()
+	}
+
+	return codec.decode(s)
+}
+
+// EncodeToString encodes the bytes to string.
+func (c TextEncoding) EncodeToString(blob []byte) (string, error) {
+	codec, ok := textEncodings[c]
+	if !ok {
+		return "", ErrUnknownTextEncoding()
+	}
+
+	return codec.encode(blob), nil
+}
+
+// IsValid reports whether the text encoding is supported.
+func (c TextEncoding) IsValid() bool {
+	_, ok := textEncodings[c]
+
+	return ok
+}
+
+// String implements stringer interface.
+func (c TextEncoding) String() string {
+	codec, ok := textEncodings[c]
+	if !ok {
+		return "unknown"
+	}
+
+	return codec.name
+}
+
+// decodeBase32 decodes case-insensitive base32 without padding.
+func decodeBase32(s string) ([]byte, error) {
+	return base32Lower.DecodeString(strings.ToLower(s))
+}
+
+// decodeTextHash decodes the string into the hash bytes.
+func decodeTextHash(dst []byte, enc TextEncoding, s string) error {
+	if !enc.IsValid() {
+		return ErrUnknownTextEncoding()
+	}
+
+	blob, err := enc.DecodeString(s)
+	if err != nil {
+		return ErrInvalidHash()
+	}
+
+	return unmarshalHash(dst, blob)
+}
+
+// encodeTextHash encodes the hash bytes to string
+// with the text encoding known to be supported.
+func encodeTextHash(enc TextEncoding, blob []byte) string {
+	s, _ := enc.EncodeToString(blob)
+
+	return s
+}
+
+// encodeTextRaw encodes raw bytes of the value to string.
+func encodeTextRaw(enc TextEncoding, raw func() ([]byte, error)) (string, error) {
+	if !enc.IsValid() {
+		return "", ErrUnknownTextEncoding()
+	}
+
+	blob, err := raw()
+	if err != nil {
+		return "", err
+	}
+
+	return enc.EncodeToString(blob)
+}
@@ -0,0 +1,275 @@
+// Copyright © 2020-2021 The EVEN Solutions Developers Team
+
+package crypto_test
+
+import (
+	"reflect"
+	"testing"
+
+	. "github.com/platsko/go-kit/crypto"
+	"github.com/platsko/go-kit/errors"
+)
+
+type (
+	// textEncoder describes the text encoding method
+	// shared by the byte-like types of the package.
+	textEncoder interface {
+		Text(TextEncoding) (string, error)
+	}
+)
+
+var (
+	// Make sure the byte-like types implement textEncoder interface.
+	_ textEncoder = Hash224{}
+	_ textEncoder = Hash256{}
+	_ textEncoder = PublicKey(nil)
+	_ textEncoder = Signature(nil)
+
+	// textEncodings is the list of supported text encodings.
+	textEncodings = [...]TextEncoding{HexEncoding, Base32Encoding, Base58Encoding, Base64Encoding}
+)
+
+func Benchmark_Hash256_Text(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		if _, err := h256.Text(Base32Encoding); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func Benchmark_ParseHash256Text(b *testing.B) {
+	str := h256.Base32()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := ParseHash256Text(Base32Encoding, str); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func Test_TextEncoding(t *testing.T) {
+	t.Parallel()
+
+	blob := []byte("yes mani !")
+	tests := [5]struct {
+		name    string
+		enc     TextEncoding
+		want    string
+		wantErr error
+	}{
+		{name: "hex_OK", enc: HexEncoding, want: "796573206d616e692021"},
+		{name: "base32_OK", enc: Base32Encoding, want: "pfsxgidnmfxgsibb"},
+		{name: "base58_OK", enc: Base58Encoding, want: "7paNL19xttacUY"},
+		{name: "base64_OK", enc: Base64Encoding, want: "eWVzIG1hbmkgIQ=="},
+		{name: "unknown_ERR", wantErr: ErrUnknownTextEncoding()},
+	}
+
+	for idx := range tests {
+		test := tests[idx]
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := test.enc.EncodeToString(blob)
+			if !errors.Is(err, test.wantErr) {
+				t.Errorf("EncodeToString() error: %v | want: %v", err, test.wantErr)
+				return
+			}
+			if got != test.want {
+				t.Errorf("EncodeToString() got: %v | want: %v", got, test.want)
+			}
+
+			decoded, err := test.enc.DecodeString(test.want)
+			if !errors.Is(err, test.wantErr) {
+				t.Errorf("DecodeString() error: %v | want: %v", err, test.wantErr)
+				return
+			}
+			if test.wantErr == nil && !reflect.DeepEqual(decoded, blob) {
+				t.Errorf("DecodeString() got: %q | want: %q", decoded, blob)
+			}
+		})
+	}
+}
+
+func Test_ParseTextEncoding(t *testing.T) {
+	t.Parallel()
+
+	for _, enc := range textEncodings {
+		got, err := ParseTextEncoding(enc.String())
+		if err != nil {
+			t.Errorf("ParseTextEncoding() error: %v | want: %v", err, nil)
+			continue
+		}
+		if got != enc || !got.IsValid() {
+			t.Errorf("ParseTextEncoding() got: %v | want: %v", got, enc)
+		}
+	}
+
+	if got, _ := ParseTextEncoding("Base58"); got != Base58Encoding {
+		t.Errorf("ParseTextEncoding() got: %v | want: %v", got, Base58Encoding)
+	}
+	if _, err := ParseTextEncoding("base36"); !errors.Is(err, ErrUnknownTextEncoding()) {
+		t.Errorf("ParseTextEncoding() error: %v | want: %v", err, ErrUnknownTextEncoding())
+	}
+	if got := TextEncoding(0).String(); got != "unknown" {
+		t.Errorf("String() got: %v | want: %v", got, "unknown")
+	}
+}
+
+func Test_Hash_Text(t *testing.T) {
+	t.Parallel()
+
+	named224 := map[TextEncoding]string{
+		HexEncoding:    h224.Hex(),
+		Base32Encoding: h224.Base32(),
+		Base58Encoding: h224.Base58(),
+		Base64Encoding: h224.Base64(),
+	}
+	named256 := map[TextEncoding]string{
+		HexEncoding:    h256.Hex(),
+		Base32Encoding: h256.Base32(),
+		Base58Encoding: h256.Base58(),
+		Base64Encoding: h256.Base64(),
+	}
+
+	for _, enc := range textEncodings {
+		str224, err := h224.Text(enc)
+		if err != nil || str224 != named224[enc] {
+			t.Errorf("Text() got: %v %v | want: %v %v", str224, err, named224[enc], nil)
+		}
+		if got, err := ParseHash224Text(enc, str224); err != nil || got != h224 {
+			t.Errorf("ParseHash224Text() got: %x %v | want: %x %v", got, err, h224, nil)
+		}
+
+		str256, err := h256.Text(enc)
+		if err != nil || str256 != named256[enc] {
+			t.Errorf("Text() got: %v %v | want: %v %v", str256, err, named256[enc], nil)
+		}
+		if got, err := ParseHash256Text(enc, str256); err != nil || got != h256 {
+			t.Errorf("ParseHash256Text() got: %x %v | want: %x %v", got, err, h256, nil)
+		}
+	}
+}
+
+func Test_ParseHash256Text_ERR(t *testing.T) {
+	t.Parallel()
+
+	tests := [4]struct {
+		name    string
+		enc     TextEncoding
+		str     string
+		wantErr error
+	}{
+		{
+			name:    "unknown_encoding_ERR",
+			str:     h256.Hex(),
+			wantErr: ErrUnknownTextEncoding(),
+		},
+		{
+			name:    "invalid_symbol_ERR",
+			enc:     Base32Encoding,
+			str:     "1" + h256.Base32()[1:],
+			wantErr: ErrInvalidHash(),
+		},
+		{
+			name:    "hash_length_ERR",
+			enc:     Base64Encoding,
+			str:     h224.Base64(),
+			wantErr: ErrInvalidHashLength(),
+		},
+		{
+			name:    "encoding_mismatch_ERR",
+			enc:     HexEncoding,
+			str:     h256.Base58(),
+			wantErr: ErrInvalidHash(),
+		},
+	}
+
+	for idx := range tests {
+		test := tests[idx]
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			if _, err := ParseHash256Text(test.enc, test.str); !errors.Is(err, test.wantErr) {
+				t.Errorf("ParseHash256Text() error: %v | want: %v", err, test.wantErr)
+			}
+		})
+	}
+}
+
+func Test_ParsePublicKeyText(t *testing.T) {
+	t.Parallel()
+
+	for _, algo := range GetAlgoList() {
+		_, pbKey := mockGenerateKeyPair(algo)
+		for _, enc := range textEncodings {
+			str, err := pbKey.Text(enc)
+			if err != nil {
+				t.Errorf("Text() error: %v | want: %v", err, nil)
+				continue
+			}
+
+			got, err := ParsePublicKeyText(algo, enc, str)
+			if err != nil {
+				t.Errorf("ParsePublicKeyText() error: %v | want: %v", err, nil)
+				continue
+			}
+			if !got.Equals(pbKey) {
+				t.Errorf("ParsePublicKeyText() got: %v | want: %v", got, pbKey)
+			}
+		}
+
+		if str, _ := pbKey.Text(HexEncoding); str != pbKey.String() {
+			t.Errorf("Text() got: %v | want: %v", str, pbKey.String())
+		}
+	}
+
+	_, pbKey := mockGenerateKeyPair(Ed25519)
+	str, _ := pbKey.Text(Base58Encoding)
+	if _, err := ParsePublicKeyText(UNKNOWN, Base58Encoding, str); !errors.Is(err, ErrUnsupportedKeyType()) {
+		t.Errorf("ParsePublicKeyText() error: %v | want: %v", err, ErrUnsupportedKeyType())
+	}
+	if _, err := ParsePublicKeyText(Ed25519, 0, str); !errors.Is(err, ErrUnknownTextEncoding()) {
+		t.Errorf("ParsePublicKeyText() error: %v | want: %v", err, ErrUnknownTextEncoding())
+	}
+	if _, err := ParsePublicKeyText(Ed25519, Base58Encoding, "0"+str); !errors.Is(err, ErrInvalidPublicKey()) {
+		t.Errorf("ParsePublicKeyText() error: %v | want: %v", err, ErrInvalidPublicKey())
+	}
+	if _, err := ParsePublicKeyText(Ed25519, Base58Encoding, str[:10]); !errors.Is(err, ErrInvalidPublicKey()) {
+		t.Errorf("ParsePublicKeyText() error: %v | want: %v", err, ErrInvalidPublicKey())
+	}
+	if _, err := NewPublicKey(nil).Text(Base32Encoding); !errors.Is(err, errors.ErrNilPointerValue()) {
+		t.Errorf("Text() error: %v | want: %v", err, errors.ErrNilPointerValue())
+	}
+}
+
+func Test_ParseSignatureText(t *testing.T) {
+	t.Parallel()
+
+	sign, _ := mockSignature(Ed25519)
+	for _, enc := range textEncodings {
+		str, err := sign.Text(enc)
+		if err != nil {
+			t.Errorf("Text() error: %v | want: %v", err, nil)
+			continue
+		}
+
+		got, err := ParseSignatureText(enc, str)
+		if err != nil {
+			t.Errorf("ParseSignatureText() error: %v | want: %v", err, nil)
+			continue
+		}
+		if !got.Equals(sign) {
+			t.Errorf("ParseSignatureText() got: %v | want: %v", got, sign)
+		}
+	}
+
+	if _, err := ParseSignatureText(Base64Encoding, "%"); !errors.Is(err, ErrInvalidSignature()) {
+		t.Errorf("ParseSignatureText() error: %v | want: %v", err, ErrInvalidSignature())
+	}
+	if _, err := ParseSignatureText(0, ""); !errors.Is(err, ErrUnknownTextEncoding()) {
+		t.Errorf("ParseSignatureText() error: %v | want: %v", err, ErrUnknownTextEncoding())
+	}
+	if _, err := NewSignature(nil).Text(Base64Encoding); !errors.Is(err, errors.ErrNilPointerValue()) {
+		t.Errorf("Text() error: %v | want: %v", err, errors.ErrNilPointerValue())
+	}
+}
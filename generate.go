@@ -6,5 +6,8 @@ package main
 // Crypto pb files generation section
 //go:generate protoc -I=. --go_out=. --go_opt=module=github.com/platsko/go-kit --go-grpc_out=. --go-grpc_opt=module=github.com/platsko/go-kit --proto_path=crypto/proto crypto/proto/*.proto
 
+// Crypto remote signer pb files generation section
+//go:generate protoc -I=. --go_out=. --go_opt=module=github.com/platsko/go-kit --go-grpc_out=. --go-grpc_opt=module=github.com/platsko/go-kit --proto_path=crypto/remote/proto crypto/remote/proto/*.proto
+
 // Timestamp pb files generation section
 //go:generate protoc -I=. --go_out=. --go_opt=module=github.com/platsko/go-kit --go-grpc_out=. --go-grpc_opt=module=github.com/platsko/go-kit --proto_path=timestamp/proto timestamp/proto/*.proto
@@ -3,8 +3,12 @@ module github.com/platsko/go-kit
 go 1.16
 
 require (
+	github.com/btcsuite/btcd v0.20.1-beta
 	github.com/golang/protobuf v1.5.1
 	github.com/json-iterator/go v1.1.10
 	github.com/libp2p/go-libp2p-core v0.8.5
+	github.com/modern-go/reflect2 v1.0.3-0.20250322232337-35a7c28c31ee // indirect
+	github.com/multiformats/go-multiaddr v0.2.2
+	golang.org/x/crypto v0.0.0-20190611184440-5c40567a22f8
 	google.golang.org/protobuf v1.26.0
 )
@@ -0,0 +1,125 @@
+// Copyright © 2020-2021 The EVEN Solutions Developers Team
+
+package jwt
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"encoding/asn1"
+	"math/big"
+
+	"github.com/platsko/go-kit/crypto"
+)
+
+const (
+	// AlgEdDSA is JWS algorithm name for Ed25519 keys.
+	AlgEdDSA = "EdDSA"
+
+	// AlgES256 is JWS algorithm name for ECDSA P-256 keys.
+	AlgES256 = "ES256"
+
+	// AlgES256K is JWS algorithm name for Secp256k1 keys.
+	AlgES256K = "ES256K"
+
+	// AlgRS256 is JWS algorithm name for RSA keys.
+	AlgRS256 = "RS256"
+
+	// ecSize defines size in bytes of the
+	// R and S values of the ECDSA signatures.
+	ecSize = 32
+)
+
+type (
+	// ecSignature represents ASN.1 DER encoded ECDSA signature.
+	ecSignature struct {
+		R, S *big.Int
+	}
+)
+
+// Alg returns JWS algorithm name for the crypto key algo.
+func Alg(algo crypto.Algo) (string, error) {
+	switch algo {
+	case crypto.Ed25519:
+		return AlgEdDSA, nil
+	case crypto.ECDSA:
+		return AlgES256, nil
+	case crypto.Secp256k1:
+		return AlgES256K, nil
+	case crypto.RSA:
+		return AlgRS256, nil
+	default:
+		return "", ErrUnsupportedAlgo()
+	}
+}
+
+// keyAlg returns JWS algorithm name for the public key
+// and makes sure ECDSA key is over P-256 curve.
+func keyAlg(pbKey crypto.PublicKey) (string, error) {
+	if pbKey == nil {
+		return "", crypto.ErrPublicKeyCannotBeNil()
+	}
+
+	alg, err := Alg(pbKey.Algo())
+	if err != nil {
+		return "", err
+	}
+
+	if alg == AlgES256 {
+		std, err := crypto.PublicKeyToStd(pbKey)
+		if err != nil {
+			return "", err
+		}
+
+		if ki, ok := std.(*ecdsa.PublicKey); !ok || ki.Curve != elliptic.P256() {
+			return "", ErrUnsupportedAlgo()
+		}
+	}
+
+	return alg, nil
+}
+
+// encodeSignature converts the crypto key signature to JWS signature bytes,
+// ASN.1 DER encoded ECDSA signatures are converted to R || S form.
+func encodeSignature(alg string, sign crypto.Signature) ([]byte, error) {
+	blob, err := sign.Raw()
+	if err != nil {
+		return nil, err
+	}
+
+	if alg != AlgES256 && alg != AlgES256K {
+		return blob, nil
+	}
+
+	ecSign := ecSignature{}
+	if _, err = asn1.Unmarshal(blob, &ecSign); err != nil {
+		return nil, err
+	}
+
+	out := make([]byte, ecSize*2) // nolint: gomnd
+	ecSign.R.FillBytes(out[:ecSize])
+	ecSign.S.FillBytes(out[ecSize:])
+
+	return out, nil
+}
+
+// decodeSignature converts JWS signature bytes to the crypto key signature,
+// ECDSA signatures in R || S form are converted to ASN.1 DER encoding.
+func decodeSignature(alg string, blob []byte) (crypto.Signature, error) {
+	if alg != AlgES256 && alg != AlgES256K {
+		return crypto.NewSignature(blob), nil
+	}
+
+	if len(blob) != ecSize*2 {
+		return nil, ErrInvalidSignature()
+	}
+
+	der, err := asn1.Marshal(ecSignature{
+		R: new(big.Int).SetBytes(blob[:ecSize]),
+		S: new(big.Int).SetBytes(blob[ecSize:]),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return crypto.NewSignature(der), nil
+}
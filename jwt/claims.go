@@ -0,0 +1,210 @@
+// Copyright © 2020-2021 The EVEN Solutions Developers Team
+
+package jwt
+
+import (
+	"math"
+	"time"
+
+	json "github.com/json-iterator/go"
+
+	"github.com/platsko/go-kit/timestamp"
+)
+
+const (
+	claimIssuer    = "iss"
+	claimSubject   = "sub"
+	claimAudience  = "aud"
+	claimExpiresAt = "exp"
+	claimNotBefore = "nbf"
+	claimIssuedAt  = "iat"
+	claimID        = "jti"
+)
+
+type (
+	// Claims represents JWT claims set with registered claims
+	// and custom claims those are encoded along with them.
+	Claims struct {
+		Issuer    string
+		Subject   string
+		Audience  []string
+		ExpiresAt *timestamp.Timestamp
+		NotBefore *timestamp.Timestamp
+		IssuedAt  *timestamp.Timestamp
+		ID        string
+
+		// Custom contains not registered claims.
+		Custom map[string]interface{}
+	}
+)
+
+// NewClaims returns claims issued at current time
+// and expires after the given ttl, zero ttl means never expires.
+func NewClaims(issuer, subject string, ttl time.Duration) *Claims {
+	now := timestamp.Timestamp{Time: timestamp.Now().Truncate(time.Second)}
+	claims := Claims{
+		Issuer:   issuer,
+		Subject:  subject,
+		IssuedAt: &now,
+	}
+
+	if ttl > 0 {
+		claims.ExpiresAt = &timestamp.Timestamp{Time: now.Add(ttl)}
+	}
+
+	return &claims
+}
+
+// HasAudience reports whether the audience claim contains the given value.
+func (c *Claims) HasAudience(aud string) bool {
+	for _, val := range c.Audience {
+		if val == aud {
+			return true
+		}
+	}
+
+	return false
+}
+
+// MarshalJSON implements marshaler interface for types
+// that can marshal themselves into valid JSON.
+func (c *Claims) MarshalJSON() ([]byte, error) {
+	claims := make(map[string]interface{}, len(c.Custom)+7) // nolint: gomnd
+	for key, val := range c.Custom {
+		claims[key] = val
+	}
+
+	setString(claims, claimIssuer, c.Issuer)
+	setString(claims, claimSubject, c.Subject)
+	setString(claims, claimID, c.ID)
+	setNumericDate(claims, claimExpiresAt, c.ExpiresAt)
+	setNumericDate(claims, claimNotBefore, c.NotBefore)
+	setNumericDate(claims, claimIssuedAt, c.IssuedAt)
+
+	switch len(c.Audience) {
+	case 0: // omit empty audience
+	case 1:
+		claims[claimAudience] = c.Audience[0]
+	default:
+		claims[claimAudience] = c.Audience
+	}
+
+	// the standard library compatible config sorts the keys,
+	// so the same claims are always encoded to the same payload
+	return json.ConfigCompatibleWithStandardLibrary.Marshal(claims)
+}
+
+// UnmarshalJSON implements unmarshaler interface for types
+// that can unmarshal a JSON description of themselves.
+func (c *Claims) UnmarshalJSON(b []byte) error {
+	claims := make(map[string]json.RawMessage)
+	if err := json.Unmarshal(b, &claims); err != nil {
+		return err
+	}
+
+	res := Claims{}
+	decoders := [...]struct {
+		key    string
+		decode func(json.RawMessage) error
+	}{
+		{key: claimIssuer, decode: stringDecoder(&res.Issuer)},
+		{key: claimSubject, decode: stringDecoder(&res.Subject)},
+		{key: claimID, decode: stringDecoder(&res.ID)},
+		{key: claimExpiresAt, decode: numericDateDecoder(&res.ExpiresAt)},
+		{key: claimNotBefore, decode: numericDateDecoder(&res.NotBefore)},
+		{key: claimIssuedAt, decode: numericDateDecoder(&res.IssuedAt)},
+		{key: claimAudience, decode: audienceDecoder(&res.Audience)},
+	}
+
+	for _, dec := range decoders {
+		raw, ok := claims[dec.key]
+		if !ok {
+			continue
+		}
+		if err := dec.decode(raw); err != nil {
+			return err
+		}
+		delete(claims, dec.key)
+	}
+
+	if len(claims) > 0 {
+		res.Custom = make(map[string]interface{}, len(claims))
+		for key, raw := range claims {
+			var val interface{}
+			if err := json.Unmarshal(raw, &val); err != nil {
+				return err
+			}
+			res.Custom[key] = val
+		}
+	}
+
+	*c = res
+
+	return nil
+}
+
+// Validate checks the time based claims at the given time.
+func (c *Claims) Validate(at time.Time) error {
+	if c.ExpiresAt != nil && !at.Before(c.ExpiresAt.Time) {
+		return ErrTokenExpired()
+	}
+
+	if c.NotBefore != nil && at.Before(c.NotBefore.Time) {
+		return ErrTokenNotValidYet()
+	}
+
+	return nil
+}
+
+// audienceDecoder returns decoder of the audience claim
+// represented either as a string or an array of strings.
+func audienceDecoder(aud *[]string) func(json.RawMessage) error {
+	return func(raw json.RawMessage) error {
+		single := ""
+		if err := json.Unmarshal(raw, &single); err == nil {
+			*aud = []string{single}
+			return nil // nolint: nlreturn
+		}
+
+		return json.Unmarshal(raw, aud)
+	}
+}
+
+// numericDateDecoder returns decoder of the NumericDate claim
+// represented as a number of seconds since the Unix epoch.
+func numericDateDecoder(ts **timestamp.Timestamp) func(json.RawMessage) error {
+	return func(raw json.RawMessage) error {
+		var secs float64
+		if err := json.Unmarshal(raw, &secs); err != nil {
+			return err
+		}
+
+		sec, frac := math.Modf(secs)
+		*ts = &timestamp.Timestamp{
+			Time: time.Unix(int64(sec), int64(frac*float64(time.Second))).UTC(),
+		}
+
+		return nil
+	}
+}
+
+// stringDecoder returns decoder of the string claim.
+func stringDecoder(str *string) func(json.RawMessage) error {
+	return func(raw json.RawMessage) error {
+		return json.Unmarshal(raw, str)
+	}
+}
+
+// setNumericDate sets the NumericDate claim if the timestamp is not nil.
+func setNumericDate(claims map[string]interface{}, key string, ts *timestamp.Timestamp) {
+	if ts != nil {
+		claims[key] = ts.Unix()
+	}
+}
+
+// setString sets the string claim if the value is not empty.
+func setString(claims map[string]interface{}, key, val string) {
+	if val != "" {
+		claims[key] = val
+	}
+}
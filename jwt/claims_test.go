@@ -0,0 +1,265 @@
+// Copyright © 2020-2021 The EVEN Solutions Developers Team
+
+package jwt_test
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/platsko/go-kit/errors"
+	. "github.com/platsko/go-kit/jwt"
+	"github.com/platsko/go-kit/timestamp"
+)
+
+func Benchmark_Claims_MarshalJSON(b *testing.B) {
+	claims := NewClaims("issuer", "subject", time.Minute)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := claims.MarshalJSON(); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func Benchmark_Claims_UnmarshalJSON(b *testing.B) {
+	blob, err := NewClaims("issuer", "subject", time.Minute).MarshalJSON()
+	if err != nil {
+		b.Fatal(err)
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if err := new(Claims).UnmarshalJSON(blob); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func Test_NewClaims(t *testing.T) {
+	t.Parallel()
+
+	tests := [2]struct {
+		name        string
+		ttl         time.Duration
+		wantExpires bool
+	}{
+		{
+			name:        "OK",
+			ttl:         time.Minute,
+			wantExpires: true,
+		},
+		{
+			name: "no_expiry_OK",
+		},
+	}
+
+	for idx := range tests {
+		test := tests[idx]
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := NewClaims("issuer", "subject", test.ttl)
+			if got.Issuer != "issuer" || got.Subject != "subject" || got.IssuedAt == nil {
+				t.Errorf("NewClaims() got: %#v", got)
+			}
+			if (got.ExpiresAt != nil) != test.wantExpires {
+				t.Errorf("NewClaims() got expires: %v | want: %v", got.ExpiresAt, test.wantExpires)
+			}
+		})
+	}
+}
+
+func Test_Claims_HasAudience(t *testing.T) {
+	t.Parallel()
+
+	claims := Claims{Audience: []string{"api", "web"}}
+	tests := [2]struct {
+		name string
+		aud  string
+		want bool
+	}{
+		{
+			name: "TRUE",
+			aud:  "web",
+			want: true,
+		},
+		{
+			name: "FALSE",
+			aud:  "cli",
+		},
+	}
+
+	for idx := range tests {
+		test := tests[idx]
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := claims.HasAudience(test.aud); got != test.want {
+				t.Errorf("HasAudience() got: %v | want: %v", got, test.want)
+			}
+		})
+	}
+}
+
+func Test_Claims_MarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	ts := timestamp.Timestamp{Time: time.Unix(1300819380, 0).UTC()}
+	tests := [3]struct {
+		name   string
+		claims Claims
+		want   string
+	}{
+		{
+			name:   "empty_OK",
+			claims: Claims{},
+			want:   `{}`,
+		},
+		{
+			name: "single_audience_OK",
+			claims: Claims{
+				Issuer:    "joe",
+				Audience:  []string{"api"},
+				ExpiresAt: &ts,
+				Custom:    map[string]interface{}{"admin": true},
+			},
+			want: `{"admin":true,"aud":"api","exp":1300819380,"iss":"joe"}`,
+		},
+		{
+			name: "OK",
+			claims: Claims{
+				Issuer:    "joe",
+				Subject:   "bob",
+				Audience:  []string{"api", "web"},
+				ExpiresAt: &ts,
+				NotBefore: &ts,
+				IssuedAt:  &ts,
+				ID:        "id",
+			},
+			want: `{"aud":["api","web"],"exp":1300819380,"iat":1300819380,"iss":"joe","jti":"id","nbf":1300819380,"sub":"bob"}`,
+		},
+	}
+
+	for idx := range tests {
+		test := tests[idx]
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			blob, err := test.claims.MarshalJSON()
+			if err != nil {
+				t.Errorf("MarshalJSON() error: %v | want: %v", err, false)
+				return
+			}
+			if got := string(blob); got != test.want {
+				t.Errorf("MarshalJSON() got: %v | want: %v", got, test.want)
+			}
+
+			claims := Claims{}
+			if err = claims.UnmarshalJSON(blob); err != nil {
+				t.Errorf("UnmarshalJSON() error: %v | want: %v", err, false)
+				return
+			}
+			if !reflect.DeepEqual(claims, test.claims) {
+				t.Errorf("UnmarshalJSON() got: %#v | want: %#v", claims, test.claims)
+			}
+		})
+	}
+}
+
+func Test_Claims_UnmarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	tests := [5]struct {
+		name    string
+		blob    string
+		want    Claims
+		wantErr bool
+	}{
+		{
+			name: "OK",
+			blob: `{"aud":["api"],"iss":"joe","x":"y"}`,
+			want: Claims{Issuer: "joe", Audience: []string{"api"}, Custom: map[string]interface{}{"x": "y"}},
+		},
+		{
+			name: "fractional_date_OK",
+			blob: `{"exp":1300819380.5}`,
+			want: Claims{ExpiresAt: &timestamp.Timestamp{Time: time.Unix(1300819380, 5e8).UTC()}},
+		},
+		{
+			name:    "invalid_JSON_ERR",
+			blob:    `{`,
+			wantErr: true,
+		},
+		{
+			name:    "invalid_date_ERR",
+			blob:    `{"exp":"tomorrow"}`,
+			wantErr: true,
+		},
+		{
+			name:    "invalid_audience_ERR",
+			blob:    `{"aud":1}`,
+			wantErr: true,
+		},
+	}
+
+	for idx := range tests {
+		test := tests[idx]
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := Claims{}
+			err := got.UnmarshalJSON([]byte(test.blob))
+			if (err != nil) != test.wantErr {
+				t.Errorf("UnmarshalJSON() error: %v | want: %v", err, test.wantErr)
+				return
+			}
+			if !test.wantErr && !reflect.DeepEqual(got, test.want) {
+				t.Errorf("UnmarshalJSON() got: %#v | want: %#v", got, test.want)
+			}
+		})
+	}
+}
+
+func Test_Claims_Validate(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	past := &timestamp.Timestamp{Time: now.Add(-time.Minute)}
+	future := &timestamp.Timestamp{Time: now.Add(time.Minute)}
+
+	tests := [4]struct {
+		name    string
+		claims  Claims
+		wantErr error
+	}{
+		{
+			name:   "OK",
+			claims: Claims{ExpiresAt: future, NotBefore: past},
+		},
+		{
+			name:   "no_time_claims_OK",
+			claims: Claims{},
+		},
+		{
+			name:    "expired_ERR",
+			claims:  Claims{ExpiresAt: past},
+			wantErr: ErrTokenExpired(),
+		},
+		{
+			name:    "not_valid_yet_ERR",
+			claims:  Claims{NotBefore: future},
+			wantErr: ErrTokenNotValidYet(),
+		},
+	}
+
+	for idx := range tests {
+		test := tests[idx]
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			if err := test.claims.Validate(now); !errors.Is(err, test.wantErr) {
+				t.Errorf("Validate() error: %v | want: %v", err, test.wantErr)
+			}
+		})
+	}
+}
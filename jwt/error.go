@@ -0,0 +1,61 @@
+// Copyright © 2020-2021 The EVEN Solutions Developers Team
+
+package jwt
+
+import (
+	"github.com/platsko/go-kit/errors"
+)
+
+const (
+	ErrAlgoMismatchMsg     = "algorithm mismatch"
+	ErrInvalidKeyMsg       = "invalid key"
+	ErrInvalidSignatureMsg = "invalid signature"
+	ErrInvalidTokenMsg     = "invalid token"
+	ErrTokenExpiredMsg     = "token expired"
+	ErrTokenNotValidYetMsg = "token not valid yet"
+	ErrUnknownKeyIDMsg     = "unknown key id"
+	ErrUnsupportedAlgoMsg  = "unsupported algorithm"
+)
+
+var (
+	errAlgoMismatch     = errors.New(ErrAlgoMismatchMsg)
+	errInvalidKey       = errors.New(ErrInvalidKeyMsg)
+	errInvalidSignature = errors.New(ErrInvalidSignatureMsg)
+	errInvalidToken     = errors.New(ErrInvalidTokenMsg)
+	errTokenExpired     = errors.New(ErrTokenExpiredMsg)
+	errTokenNotValidYet = errors.New(ErrTokenNotValidYetMsg)
+	errUnknownKeyID     = errors.New(ErrUnknownKeyIDMsg)
+	errUnsupportedAlgo  = errors.New(ErrUnsupportedAlgoMsg)
+)
+
+func ErrAlgoMismatch() error {
+	return errAlgoMismatch
+}
+
+func ErrInvalidKey() error {
+	return errInvalidKey
+}
+
+func ErrInvalidSignature() error {
+	return errInvalidSignature
+}
+
+func ErrInvalidToken() error {
+	return errInvalidToken
+}
+
+func ErrTokenExpired() error {
+	return errTokenExpired
+}
+
+func ErrTokenNotValidYet() error {
+	return errTokenNotValidYet
+}
+
+func ErrUnknownKeyID() error {
+	return errUnknownKeyID
+}
+
+func ErrUnsupportedAlgo() error {
+	return errUnsupportedAlgo
+}
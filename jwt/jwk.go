@@ -0,0 +1,220 @@
+// Copyright © 2020-2021 The EVEN Solutions Developers Team
+
+package jwt
+
+import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"math/big"
+
+	"github.com/btcsuite/btcd/btcec"
+
+	"github.com/platsko/go-kit/crypto"
+)
+
+const (
+	// KeyTypeEC is JWK key type of elliptic curve keys.
+	KeyTypeEC = "EC"
+
+	// KeyTypeOKP is JWK key type of octet key pairs.
+	KeyTypeOKP = "OKP"
+
+	// KeyTypeRSA is JWK key type of RSA keys.
+	KeyTypeRSA = "RSA"
+
+	// CurveEd25519 is JWK curve name of Ed25519 keys.
+	CurveEd25519 = "Ed25519"
+
+	// CurveP256 is JWK curve name of ECDSA P-256 keys.
+	CurveP256 = "P-256"
+
+	// CurveSecp256k1 is JWK curve name of Secp256k1 keys.
+	CurveSecp256k1 = "secp256k1"
+
+	// UseSignature is JWK public key use for signatures.
+	UseSignature = "sig"
+)
+
+type (
+	// JWK represents JSON Web Key of the public key.
+	JWK struct {
+		KeyType   string `json:"kty"`
+		KeyID     string `json:"kid,omitempty"`
+		Use       string `json:"use,omitempty"`
+		Algorithm string `json:"alg,omitempty"`
+		Curve     string `json:"crv,omitempty"`
+		X         string `json:"x,omitempty"`
+		Y         string `json:"y,omitempty"`
+		N         string `json:"n,omitempty"`
+		E         string `json:"e,omitempty"`
+	}
+
+	// JWKSet represents JSON Web Key Set.
+	JWKSet struct {
+		Keys []JWK `json:"keys"`
+	}
+)
+
+// NewJWK exports the public key as JWK,
+// the key id is set to RFC 7638 thumbprint of the key.
+func NewJWK(pbKey crypto.PublicKey) (*JWK, error) {
+	alg, err := keyAlg(pbKey)
+	if err != nil {
+		return nil, err
+	}
+
+	std, err := crypto.PublicKeyToStd(pbKey)
+	if err != nil {
+		return nil, err
+	}
+
+	jwk := JWK{Use: UseSignature, Algorithm: alg}
+	switch key := std.(type) {
+	case ed25519.PublicKey:
+		jwk.KeyType, jwk.Curve, jwk.X = KeyTypeOKP, CurveEd25519, encodeSegment(key)
+
+	case *ecdsa.PublicKey:
+		jwk.KeyType, jwk.Curve = KeyTypeEC, CurveP256
+		if alg == AlgES256K {
+			jwk.Curve = CurveSecp256k1
+		}
+		jwk.X = encodeSegment(key.X.FillBytes(make([]byte, ecSize)))
+		jwk.Y = encodeSegment(key.Y.FillBytes(make([]byte, ecSize)))
+
+	case *rsa.PublicKey:
+		jwk.KeyType = KeyTypeRSA
+		jwk.N = encodeSegment(key.N.Bytes())
+		jwk.E = encodeSegment(big.NewInt(int64(key.E)).Bytes())
+
+	default:
+		return nil, crypto.ErrUnsupportedKeyType()
+	}
+
+	if jwk.KeyID, err = jwk.Thumbprint(); err != nil {
+		return nil, err
+	}
+
+	return &jwk, nil
+}
+
+// NewJWKSet exports the public keys as JWK Set.
+func NewJWKSet(keys ...crypto.PublicKey) (*JWKSet, error) {
+	set := JWKSet{Keys: make([]JWK, 0, len(keys))}
+	for _, pbKey := range keys {
+		jwk, err := NewJWK(pbKey)
+		if err != nil {
+			return nil, err
+		}
+		set.Keys = append(set.Keys, *jwk)
+	}
+
+	return &set, nil
+}
+
+// PublicKey imports the public key from JWK.
+func (c *JWK) PublicKey() (crypto.PublicKey, error) {
+	switch {
+	case c.KeyType == KeyTypeOKP && c.Curve == CurveEd25519:
+		x, err := decodeSegment(c.X)
+		if err != nil || len(x) != ed25519.PublicKeySize {
+			return nil, ErrInvalidKey()
+		}
+
+		return crypto.PublicKeyFromStd(ed25519.PublicKey(x))
+
+	case c.KeyType == KeyTypeEC && c.Curve == CurveP256:
+		return c.ecPublicKey(elliptic.P256())
+
+	case c.KeyType == KeyTypeEC && c.Curve == CurveSecp256k1:
+		return c.ecPublicKey(btcec.S256())
+
+	case c.KeyType == KeyTypeRSA:
+		n, err := decodeSegment(c.N)
+		if err != nil {
+			return nil, ErrInvalidKey()
+		}
+
+		e, err := decodeSegment(c.E)
+		if err != nil || len(e) == 0 || len(e) > 4 {
+			return nil, ErrInvalidKey()
+		}
+
+		return crypto.PublicKeyFromStd(&rsa.PublicKey{
+			N: new(big.Int).SetBytes(n),
+			E: int(new(big.Int).SetBytes(e).Int64()),
+		})
+
+	default:
+		return nil, crypto.ErrUnsupportedKeyType()
+	}
+}
+
+// Thumbprint returns RFC 7638 thumbprint of the key
+// calculated as SHA256 checksum over the required members.
+func (c *JWK) Thumbprint() (string, error) {
+	var members string
+	switch c.KeyType {
+	case KeyTypeEC:
+		members = `{"crv":"` + c.Curve + `","kty":"` + c.KeyType + `","x":"` + c.X + `","y":"` + c.Y + `"}`
+	case KeyTypeOKP:
+		members = `{"crv":"` + c.Curve + `","kty":"` + c.KeyType + `","x":"` + c.X + `"}`
+	case KeyTypeRSA:
+		members = `{"e":"` + c.E + `","kty":"` + c.KeyType + `","n":"` + c.N + `"}`
+	default:
+		return "", crypto.ErrUnsupportedKeyType()
+	}
+
+	h256 := sha256.Sum256([]byte(members))
+
+	return encodeSegment(h256[:]), nil
+}
+
+// Lookup returns the key with the given key id.
+func (c *JWKSet) Lookup(kid string) (*JWK, bool) {
+	for idx := range c.Keys {
+		if c.Keys[idx].KeyID == kid {
+			return &c.Keys[idx], true
+		}
+	}
+
+	return nil, false
+}
+
+// ecPublicKey imports the elliptic curve public key over the given curve.
+func (c *JWK) ecPublicKey(curve elliptic.Curve) (crypto.PublicKey, error) {
+	x, err := decodeSegment(c.X)
+	if err != nil || len(x) != ecSize {
+		return nil, ErrInvalidKey()
+	}
+
+	y, err := decodeSegment(c.Y)
+	if err != nil || len(y) != ecSize {
+		return nil, ErrInvalidKey()
+	}
+
+	key := ecdsa.PublicKey{
+		Curve: curve,
+		X:     new(big.Int).SetBytes(x),
+		Y:     new(big.Int).SetBytes(y),
+	}
+
+	if !curve.IsOnCurve(key.X, key.Y) {
+		return nil, ErrInvalidKey()
+	}
+
+	return crypto.PublicKeyFromStd(&key)
+}
+
+// decodeSegment decodes base64url encoded string without padding.
+func decodeSegment(s string) ([]byte, error) {
+	return base64.RawURLEncoding.DecodeString(s)
+}
+
+// encodeSegment encodes bytes to base64url string without padding.
+func encodeSegment(b []byte) string {
+	return base64.RawURLEncoding.EncodeToString(b)
+}
@@ -0,0 +1,253 @@
+// Copyright © 2020-2021 The EVEN Solutions Developers Team
+
+package jwt_test
+
+import (
+	"testing"
+
+	"github.com/platsko/go-kit/crypto"
+	. "github.com/platsko/go-kit/jwt"
+)
+
+func Benchmark_NewJWK(b *testing.B) {
+	_, pbKey := mockGenerateKeyPair(crypto.Ed25519)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := NewJWK(pbKey); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func Test_Alg(t *testing.T) {
+	t.Parallel()
+
+	tests := [5]struct {
+		name    string
+		algo    crypto.Algo
+		want    string
+		wantErr bool
+	}{
+		{name: "Ed25519_OK", algo: crypto.Ed25519, want: AlgEdDSA},
+		{name: "ECDSA_OK", algo: crypto.ECDSA, want: AlgES256},
+		{name: "Secp256k1_OK", algo: crypto.Secp256k1, want: AlgES256K},
+		{name: "RSA_OK", algo: crypto.RSA, want: AlgRS256},
+		{name: "UNKNOWN_ERR", algo: crypto.UNKNOWN, wantErr: true},
+	}
+
+	for idx := range tests {
+		test := tests[idx]
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := Alg(test.algo)
+			if (err != nil) != test.wantErr {
+				t.Errorf("Alg() error: %v | want: %v", err, test.wantErr)
+				return
+			}
+			if got != test.want {
+				t.Errorf("Alg() got: %v | want: %v", got, test.want)
+			}
+		})
+	}
+}
+
+func Test_NewJWK(t *testing.T) {
+	t.Parallel()
+
+	type (
+		testCase struct {
+			name    string
+			pbKey   crypto.PublicKey
+			wantKty string
+			wantErr bool
+		}
+		testList []testCase
+	)
+
+	kty := map[crypto.Algo]string{
+		crypto.Ed25519:   KeyTypeOKP,
+		crypto.ECDSA:     KeyTypeEC,
+		crypto.Secp256k1: KeyTypeEC,
+		crypto.RSA:       KeyTypeRSA,
+	}
+
+	algos := crypto.GetAlgos()
+	tests := make(testList, 0, algos.Len()+2)
+	for name, algo := range algos {
+		_, pbKey := mockGenerateKeyPair(algo)
+		tests = append(tests, testCase{
+			name:    name + "_OK",
+			pbKey:   pbKey,
+			wantKty: kty[algo],
+		})
+	}
+
+	_, p384 := mockKeyPairP384()
+	tests = append(tests, testCase{
+		name:    "ECDSA_P384_ERR",
+		pbKey:   p384,
+		wantErr: true,
+	}, testCase{
+		name:    "nil_PublicKey_ERR",
+		wantErr: true,
+	})
+
+	for idx := range tests {
+		test := tests[idx]
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			jwk, err := NewJWK(test.pbKey)
+			if (err != nil) != test.wantErr {
+				t.Errorf("NewJWK() error: %v | want: %v", err, test.wantErr)
+				return
+			}
+			if test.wantErr {
+				return
+			}
+			if jwk.KeyType != test.wantKty {
+				t.Errorf("NewJWK() got: %v | want: %v", jwk.KeyType, test.wantKty)
+			}
+
+			got, err := jwk.PublicKey()
+			if err != nil {
+				t.Errorf("PublicKey() error: %v | want: %v", err, false)
+				return
+			}
+			if !got.Equals(test.pbKey) {
+				t.Errorf("PublicKey() got: %v | want: %v", got, test.pbKey)
+			}
+		})
+	}
+}
+
+func Test_JWK_PublicKey(t *testing.T) {
+	t.Parallel()
+
+	_, pbKey := mockGenerateKeyPair(crypto.ECDSA)
+	jwk, err := NewJWK(pbKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	offCurve := *jwk
+	offCurve.Y = offCurve.X
+
+	tests := [4]struct {
+		name string
+		jwk  JWK
+	}{
+		{
+			name: "off_curve_ERR",
+			jwk:  offCurve,
+		},
+		{
+			name: "bad_OKP_ERR",
+			jwk:  JWK{KeyType: KeyTypeOKP, Curve: CurveEd25519, X: "AQAB"},
+		},
+		{
+			name: "bad_RSA_ERR",
+			jwk:  JWK{KeyType: KeyTypeRSA, N: "!", E: "AQAB"},
+		},
+		{
+			name: "unknown_kty_ERR",
+			jwk:  JWK{KeyType: "oct"},
+		},
+	}
+
+	for idx := range tests {
+		test := tests[idx]
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			if _, err := test.jwk.PublicKey(); err == nil {
+				t.Errorf("PublicKey() error: %v | want: %v", err, true)
+			}
+		})
+	}
+}
+
+func Test_JWK_Thumbprint(t *testing.T) {
+	t.Parallel()
+
+	// RFC 7638 section 3.1 example key.
+	jwk := JWK{
+		KeyType: KeyTypeRSA,
+		N: "0vx7agoebGcQSuuPiLJXZptN9nndrQmbXEps2aiAFbWhM78LhWx4cbbfAAtVT86zwu1RK7aPFFxuhDR1L6tSoc_BJECPebWKRXjBZCiFV4n3oknjhMstn" +
+			"64tZ_2W-5JsGY4Hc5n9yBXArwl93lqt7_RN5w6Cf0h4QyQ5v-65YGjQR0_FDW2QvzqY368QQMicAtaSqzs8KJZgnYb9c7d0zgdAZHzu6qMQvRL5hajrn1n91Cb" +
+			"OpbISD08qNLyrdkt-bFTWhAI4vMQFh6WeZu0fM4lFd2NcRwr3XPksINHaQ-G_xBniIqbw0Ls1jF44-csFCur-kEgU8awapJzKnqDKgw",
+		E: "AQAB",
+	}
+
+	tests := [2]struct {
+		name    string
+		jwk     JWK
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "OK",
+			jwk:  jwk,
+			want: "NzbLsXh8uDCcd-6MNwXF4W_7noWXFZAfHkxZsRGC9Xs",
+		},
+		{
+			name:    "ERR",
+			jwk:     JWK{KeyType: "oct"},
+			wantErr: true,
+		},
+	}
+
+	for idx := range tests {
+		test := tests[idx]
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := test.jwk.Thumbprint()
+			if (err != nil) != test.wantErr {
+				t.Errorf("Thumbprint() error: %v | want: %v", err, test.wantErr)
+				return
+			}
+			if got != test.want {
+				t.Errorf("Thumbprint() got: %v | want: %v", got, test.want)
+			}
+		})
+	}
+}
+
+func Test_JWKSet_Lookup(t *testing.T) {
+	t.Parallel()
+
+	_, pbKey := mockGenerateKeyPair(crypto.Ed25519)
+	set, err := NewJWKSet(pbKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := [2]struct {
+		name string
+		kid  string
+		want bool
+	}{
+		{
+			name: "TRUE",
+			kid:  set.Keys[0].KeyID,
+			want: true,
+		},
+		{
+			name: "FALSE",
+			kid:  "unknown",
+		},
+	}
+
+	for idx := range tests {
+		test := tests[idx]
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			if _, got := set.Lookup(test.kid); got != test.want {
+				t.Errorf("Lookup() got: %v | want: %v", got, test.want)
+			}
+		})
+	}
+}
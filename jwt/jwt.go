@@ -0,0 +1,160 @@
+// Copyright © 2020-2021 The EVEN Solutions Developers Team
+
+package jwt
+
+import (
+	"strings"
+	"time"
+
+	json "github.com/json-iterator/go"
+
+	"github.com/platsko/go-kit/crypto"
+	"github.com/platsko/go-kit/errors"
+)
+
+const (
+	// TypeJWT is JOSE header type of JWT.
+	TypeJWT = "JWT"
+
+	// segments defines number of the compact JWS segments.
+	segments = 3
+)
+
+type (
+	// Header represents JOSE header of the token.
+	Header struct {
+		Algorithm string `json:"alg"`
+		Type      string `json:"typ,omitempty"`
+		KeyID     string `json:"kid,omitempty"`
+	}
+)
+
+// Sign issues compact JWS token over the claims signed with the private key,
+// the key id header is set to RFC 7638 thumbprint of the public key.
+func Sign(prKey crypto.PrivateKey, claims *Claims) (string, error) {
+	if prKey == nil || claims == nil {
+		return "", errors.ErrNilPointerValue()
+	}
+
+	jwk, err := NewJWK(prKey.PublicKey())
+	if err != nil {
+		return "", err
+	}
+
+	header, err := json.Marshal(Header{Algorithm: jwk.Algorithm, Type: TypeJWT, KeyID: jwk.KeyID})
+	if err != nil {
+		return "", err
+	}
+
+	payload, err := claims.MarshalJSON()
+	if err != nil {
+		return "", err
+	}
+
+	input := encodeSegment(header) + "." + encodeSegment(payload)
+	sign, err := prKey.SignMessage([]byte(input))
+	if err != nil {
+		return "", err
+	}
+
+	blob, err := encodeSignature(jwk.Algorithm, sign)
+	if err != nil {
+		return "", err
+	}
+
+	return input + "." + encodeSegment(blob), nil
+}
+
+// Verify verifies compact JWS token signed with the private key paired
+// with the given public key and validates the claims at current time.
+func Verify(token string, pbKey crypto.PublicKey) (*Claims, error) {
+	alg, err := keyAlg(pbKey)
+	if err != nil {
+		return nil, err
+	}
+
+	parts, header, err := parse(token)
+	if err != nil {
+		return nil, err
+	}
+
+	if header.Algorithm != alg {
+		return nil, ErrAlgoMismatch()
+	}
+
+	blob, err := decodeSegment(parts[2])
+	if err != nil {
+		return nil, ErrInvalidToken()
+	}
+
+	sign, err := decodeSignature(alg, blob)
+	if err != nil {
+		return nil, err
+	}
+
+	ok, err := pbKey.VerifyMessage([]byte(parts[0]+"."+parts[1]), sign)
+	if err != nil || !ok {
+		return nil, ErrInvalidSignature()
+	}
+
+	payload, err := decodeSegment(parts[1])
+	if err != nil {
+		return nil, ErrInvalidToken()
+	}
+
+	claims := Claims{}
+	if err = claims.UnmarshalJSON(payload); err != nil {
+		return nil, ErrInvalidToken()
+	}
+
+	if err = claims.Validate(time.Now()); err != nil {
+		return nil, err
+	}
+
+	return &claims, nil
+}
+
+// VerifyWithSet verifies compact JWS token with the key
+// from the set looked up by the key id header of the token.
+func VerifyWithSet(token string, set *JWKSet) (*Claims, error) {
+	if set == nil {
+		return nil, ErrUnknownKeyID()
+	}
+
+	_, header, err := parse(token)
+	if err != nil {
+		return nil, err
+	}
+
+	jwk, ok := set.Lookup(header.KeyID)
+	if !ok {
+		return nil, ErrUnknownKeyID()
+	}
+
+	pbKey, err := jwk.PublicKey()
+	if err != nil {
+		return nil, err
+	}
+
+	return Verify(token, pbKey)
+}
+
+// parse splits compact JWS token and decodes its header.
+func parse(token string) ([]string, *Header, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != segments {
+		return nil, nil, ErrInvalidToken()
+	}
+
+	blob, err := decodeSegment(parts[0])
+	if err != nil {
+		return nil, nil, ErrInvalidToken()
+	}
+
+	header := Header{}
+	if err = json.Unmarshal(blob, &header); err != nil {
+		return nil, nil, ErrInvalidToken()
+	}
+
+	return parts, &header, nil
+}
@@ -0,0 +1,301 @@
+// Copyright © 2020-2021 The EVEN Solutions Developers Team
+
+package jwt_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	gocrypto "crypto"
+
+	"github.com/platsko/go-kit/crypto"
+	"github.com/platsko/go-kit/errors"
+	. "github.com/platsko/go-kit/jwt"
+	"github.com/platsko/go-kit/timestamp"
+)
+
+func Benchmark_Sign(b *testing.B) {
+	prKey, _ := mockGenerateKeyPair(crypto.Ed25519)
+	claims := NewClaims("issuer", "subject", time.Minute)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := Sign(prKey, claims); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func Benchmark_Verify(b *testing.B) {
+	token, pbKey := mockToken(crypto.Ed25519, NewClaims("issuer", "subject", time.Minute))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := Verify(token, pbKey); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func Test_Sign(t *testing.T) {
+	t.Parallel()
+
+	type (
+		testCase struct {
+			name    string
+			prKey   crypto.PrivateKey
+			claims  *Claims
+			wantErr bool
+		}
+		testList []testCase
+	)
+
+	algos := crypto.GetAlgos()
+	tests := make(testList, 0, algos.Len()+3)
+	for name, algo := range algos {
+		prKey, _ := mockGenerateKeyPair(algo)
+		tests = append(tests, testCase{
+			name:   name + "_OK",
+			prKey:  prKey,
+			claims: NewClaims("issuer", "subject", time.Minute),
+		})
+	}
+
+	prKey, _ := mockKeyPairP384()
+	tests = append(tests, testCase{
+		name:    "ECDSA_P384_ERR",
+		prKey:   prKey,
+		claims:  NewClaims("issuer", "subject", time.Minute),
+		wantErr: true,
+	}, testCase{
+		name:    "nil_PrivateKey_ERR",
+		claims:  NewClaims("issuer", "subject", time.Minute),
+		wantErr: true,
+	}, testCase{
+		name:    "nil_Claims_ERR",
+		prKey:   prKey,
+		wantErr: true,
+	})
+
+	for idx := range tests {
+		test := tests[idx]
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			token, err := Sign(test.prKey, test.claims)
+			if (err != nil) != test.wantErr {
+				t.Errorf("Sign() error: %v | want: %v", err, test.wantErr)
+				return
+			}
+			if test.wantErr {
+				return
+			}
+
+			got, err := Verify(token, test.prKey.PublicKey())
+			if err != nil {
+				t.Errorf("Verify() error: %v | want: %v", err, false)
+				return
+			}
+			if got.Issuer != test.claims.Issuer || got.Subject != test.claims.Subject {
+				t.Errorf("Verify() got: %#v | want: %#v", got, test.claims)
+			}
+		})
+	}
+}
+
+func Test_Sign_Interop(t *testing.T) {
+	t.Parallel()
+
+	algos := crypto.GetAlgos()
+	for name, algo := range algos {
+		algo := algo
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			token, pbKey := mockToken(algo, NewClaims("issuer", "subject", time.Minute))
+			std, err := crypto.PublicKeyToStd(pbKey)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			idx := strings.LastIndexByte(token, '.')
+			input, sign := []byte(token[:idx]), token[idx+1:]
+			blob, err := base64.RawURLEncoding.DecodeString(sign)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			h256 := sha256.Sum256(input)
+			ok := false
+			switch key := std.(type) {
+			case ed25519.PublicKey:
+				ok = ed25519.Verify(key, input, blob)
+			case *ecdsa.PublicKey:
+				r, s := new(big.Int).SetBytes(blob[:32]), new(big.Int).SetBytes(blob[32:])
+				ok = len(blob) == 64 && ecdsa.Verify(key, h256[:], r, s)
+			case *rsa.PublicKey:
+				ok = rsa.VerifyPKCS1v15(key, gocrypto.SHA256, h256[:], blob) == nil
+			}
+
+			if !ok {
+				t.Errorf("Sign() got token not verified with standard library: %v", token)
+			}
+		})
+	}
+}
+
+func Test_Verify(t *testing.T) {
+	t.Parallel()
+
+	valid := NewClaims("issuer", "subject", time.Minute)
+	token, pbKey := mockToken(crypto.Ed25519, valid)
+
+	expired := NewClaims("issuer", "subject", time.Minute)
+	expired.ExpiresAt.Time = time.Now().Add(-time.Second)
+
+	notYet := NewClaims("issuer", "subject", time.Minute)
+	notYet.NotBefore = &timestamp.Timestamp{Time: time.Now().Add(time.Minute)}
+
+	_, notEq := mockGenerateKeyPair(crypto.Ed25519)
+	_, ecKey := mockGenerateKeyPair(crypto.ECDSA)
+	parts := strings.Split(token, ".")
+	tampered := parts[0] + "." + base64.RawURLEncoding.EncodeToString([]byte(`{"sub":"admin"}`)) + "." + parts[2]
+	none := base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"none"}`)) + "." + parts[1] + "."
+
+	tests := [8]struct {
+		name    string
+		token   string
+		pbKey   crypto.PublicKey
+		wantErr error
+	}{
+		{
+			name:  "OK",
+			token: token,
+			pbKey: pbKey,
+		},
+		{
+			name:    "nil_PublicKey_ERR",
+			token:   token,
+			wantErr: crypto.ErrPublicKeyCannotBeNil(),
+		},
+		{
+			name:    "wrong_key_ERR",
+			token:   token,
+			pbKey:   notEq,
+			wantErr: ErrInvalidSignature(),
+		},
+		{
+			name:    "algo_mismatch_ERR",
+			token:   token,
+			pbKey:   ecKey,
+			wantErr: ErrAlgoMismatch(),
+		},
+		{
+			name:    "tampered_ERR",
+			token:   tampered,
+			pbKey:   pbKey,
+			wantErr: ErrInvalidSignature(),
+		},
+		{
+			name:    "alg_none_ERR",
+			token:   none,
+			pbKey:   pbKey,
+			wantErr: ErrAlgoMismatch(),
+		},
+		{
+			name:    "malformed_ERR",
+			token:   parts[0] + "." + parts[1],
+			pbKey:   pbKey,
+			wantErr: ErrInvalidToken(),
+		},
+		{
+			name:    "bad_header_ERR",
+			token:   "e30!." + parts[1] + "." + parts[2],
+			pbKey:   pbKey,
+			wantErr: ErrInvalidToken(),
+		},
+	}
+
+	for idx := range tests {
+		test := tests[idx]
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := Verify(test.token, test.pbKey)
+			if !errors.Is(err, test.wantErr) {
+				t.Errorf("Verify() error: %v | want: %v", err, test.wantErr)
+			}
+		})
+	}
+
+	t.Run("time_claims_ERR", func(t *testing.T) {
+		t.Parallel()
+
+		prKey, _ := mockGenerateKeyPair(crypto.Ed25519)
+		for claims, wantErr := range map[*Claims]error{
+			expired: ErrTokenExpired(),
+			notYet:  ErrTokenNotValidYet(),
+		} {
+			tkn, err := Sign(prKey, claims)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if _, err = Verify(tkn, prKey.PublicKey()); !errors.Is(err, wantErr) {
+				t.Errorf("Verify() error: %v | want: %v", err, wantErr)
+			}
+		}
+	})
+}
+
+func Test_VerifyWithSet(t *testing.T) {
+	t.Parallel()
+
+	token, pbKey := mockToken(crypto.Secp256k1, NewClaims("issuer", "subject", time.Minute))
+	_, other := mockGenerateKeyPair(crypto.ECDSA)
+
+	set, err := NewJWKSet(other, pbKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	unknown, err := NewJWKSet(other)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := [3]struct {
+		name    string
+		set     *JWKSet
+		wantErr error
+	}{
+		{
+			name: "OK",
+			set:  set,
+		},
+		{
+			name:    "unknown_key_ERR",
+			set:     unknown,
+			wantErr: ErrUnknownKeyID(),
+		},
+		{
+			name:    "nil_set_ERR",
+			wantErr: ErrUnknownKeyID(),
+		},
+	}
+
+	for idx := range tests {
+		test := tests[idx]
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			if _, err := VerifyWithSet(token, test.set); !errors.Is(err, test.wantErr) {
+				t.Errorf("VerifyWithSet() error: %v | want: %v", err, test.wantErr)
+			}
+		})
+	}
+}
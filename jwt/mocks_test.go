@@ -0,0 +1,47 @@
+// Copyright © 2020-2021 The EVEN Solutions Developers Team
+
+package jwt_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+
+	cc "github.com/libp2p/go-libp2p-core/crypto"
+
+	"github.com/platsko/go-kit/crypto"
+	. "github.com/platsko/go-kit/jwt"
+)
+
+func mockGenerateKeyPair(algo crypto.Algo) (crypto.PrivateKey, crypto.PublicKey) {
+	prKey, pbKey, err := crypto.GenerateKeyPair(algo)
+	if err != nil {
+		panic(err)
+	}
+
+	return prKey, pbKey
+}
+
+func mockKeyPairP384() (crypto.PrivateKey, crypto.PublicKey) {
+	ki, err := ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+	if err != nil {
+		panic(err)
+	}
+
+	prKi, pbKi, err := cc.ECDSAKeyPairFromKey(ki)
+	if err != nil {
+		panic(err)
+	}
+
+	return crypto.NewPrivateKey(prKi), crypto.NewPublicKey(pbKi)
+}
+
+func mockToken(algo crypto.Algo, claims *Claims) (string, crypto.PublicKey) {
+	prKey, pbKey := mockGenerateKeyPair(algo)
+	token, err := Sign(prKey, claims)
+	if err != nil {
+		panic(err)
+	}
+
+	return token, pbKey
+}
@@ -0,0 +1,25 @@
+// Copyright © 2020-2021 The EVEN Solutions Developers Team
+
+package multibase
+
+import (
+	"github.com/platsko/go-kit/errors"
+)
+
+const (
+	ErrEmptyInputMsg      = "empty input"
+	ErrUnsupportedBaseMsg = "unsupported base"
+)
+
+var (
+	errEmptyInput      = errors.New(ErrEmptyInputMsg)
+	errUnsupportedBase = errors.New(ErrUnsupportedBaseMsg)
+)
+
+func ErrEmptyInput() error {
+	return errEmptyInput
+}
+
+func ErrUnsupportedBase() error {
+	return errUnsupportedBase
+}
@@ -0,0 +1,157 @@
+// Copyright © 2020-2021 The EVEN Solutions Developers Team
+
+package multibase
+
+import (
+	"encoding/base32"
+	"encoding/base64"
+	"encoding/hex"
+	"sort"
+	"strings"
+
+	"github.com/platsko/go-kit/base58"
+	"github.com/platsko/go-kit/errors"
+)
+
+const (
+	// Base16 is the multibase prefix of lower case hex encoding.
+	Base16 Base = 'f'
+
+	// Base16Upper is the multibase prefix of upper case hex encoding.
+	Base16Upper Base = 'F'
+
+	// Base32 is the multibase prefix of lower case RFC 4648 base32 encoding without padding.
+	Base32 Base = 'b'
+
+	// Base32Upper is the multibase prefix of upper case RFC 4648 base32 encoding without padding.
+	Base32Upper Base = 'B'
+
+	// Base58BTC is the multibase prefix of base58 encoding with the Bitcoin alphabet.
+	Base58BTC Base = 'z'
+
+	// Base58Flickr is the multibase prefix of base58 encoding with the Flickr alphabet.
+	Base58Flickr Base = 'Z'
+
+	// Base64 is the multibase prefix of RFC 4648 base64 encoding without padding.
+	Base64 Base = 'm'
+
+	// Base64Pad is the multibase prefix of RFC 4648 base64 encoding with padding.
+	Base64Pad Base = 'M'
+
+	// Base64URL is the multibase prefix of RFC 4648 base64url encoding without padding.
+	Base64URL Base = 'u'
+
+	// Base64URLPad is the multibase prefix of RFC 4648 base64url encoding with padding.
+	Base64URLPad Base = 'U'
+)
+
+type (
+	// Base represents multibase prefix character of the encoding.
+	Base byte
+)
+
+var (
+	// base32Lower is RFC 4648 base32 encoding with lower case alphabet.
+	base32Lower = base32.NewEncoding("abcdefghijklmnopqrstuvwxyz234567").WithPadding(base32.NoPadding)
+
+	// base32Upper is RFC 4648 base32 encoding with upper case alphabet.
+	base32Upper = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+	// bases maps supported bases to its names and codecs.
+	bases = map[Base]struct {
+		name   string
+		encode func([]byte) string
+		decode func(string) ([]byte, error)
+	}{
+		Base16:       {name: "base16", encode: hex.EncodeToString, decode: hex.DecodeString},
+		Base16Upper:  {name: "base16upper", encode: encodeHexUpper, decode: hex.DecodeString},
+		Base32:       {name: "base32", encode: base32Lower.EncodeToString, decode: decodeBase32},
+		Base32Upper:  {name: "base32upper", encode: base32Upper.EncodeToString, decode: decodeBase32},
+		Base58BTC:    {name: "base58btc", encode: base58.BTCEncoding.EncodeToString, decode: base58.BTCEncoding.DecodeString},
+		Base58Flickr: {name: "base58flickr", encode: base58.FlickrEncoding.EncodeToString, decode: base58.FlickrEncoding.DecodeString},
+		Base64:       {name: "base64", encode: base64.RawStdEncoding.EncodeToString, decode: base64.RawStdEncoding.DecodeString},
+		Base64Pad:    {name: "base64pad", encode: base64.StdEncoding.EncodeToString, decode: base64.StdEncoding.DecodeString},
+		Base64URL:    {name: "base64url", encode: base64.RawURLEncoding.EncodeToString, decode: base64.RawURLEncoding.DecodeString},
+		Base64URLPad: {name: "base64urlpad", encode: base64.URLEncoding.EncodeToString, decode: base64.URLEncoding.DecodeString},
+	}
+)
+
+// Encode encodes the data with the base and prepends the base prefix.
+func Encode(base Base, data []byte) (string, error) {
+	codec, ok := bases[base]
+	if !ok {
+		return "", ErrUnsupportedBase()
+	}
+
+	return string(base) + codec.encode(data), nil
+}
+
+// Decode detects the base by the prefix and decodes the data.
+func Decode(s string) (Base, []byte, error) {
+	if s == "" {
+		return 0, nil, ErrEmptyInput()
+	}
+
+	base := Base(s[0])
+	codec, ok := bases[base]
+	if !ok {
+		return 0, nil, ErrUnsupportedBase()
+	}
+
+	data, err := codec.decode(s[1:])
+	if err != nil {
+		return 0, nil, errors.WrapErr(codec.name, err)
+	}
+
+	return base, data, nil
+}
+
+// GetBases returns a list of all supported bases sorted by prefix.
+func GetBases() []Base {
+	list := make([]Base, 0, len(bases))
+	for base := range bases {
+		list = append(list, base)
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i] < list[j] })
+
+	return list
+}
+
+// ParseBase returns the base by its multibase name.
+func ParseBase(name string) (Base, error) {
+	for base, codec := range bases {
+		if codec.name == name {
+			return base, nil
+		}
+	}
+
+	return 0, ErrUnsupportedBase()
+}
+
+// IsValid reports whether the base is supported.
+func (c Base) IsValid() bool {
+	_, ok := bases[c]
+
+	return ok
+}
+
+// String implements stringer interface.
+// It returns the multibase name of the base.
+func (c Base) String() string {
+	codec, ok := bases[c]
+	if !ok {
+		return "unknown"
+	}
+
+	return codec.name
+}
+
+// decodeBase32 decodes case-insensitive base32 without padding.
+func decodeBase32(s string) ([]byte, error) {
+	return base32Upper.DecodeString(strings.ToUpper(s))
+}
+
+// encodeHexUpper encodes the data to upper case hex.
+func encodeHexUpper(data []byte) string {
+	return strings.ToUpper(hex.EncodeToString(data))
+}
@@ -0,0 +1,187 @@
+// Copyright © 2020-2021 The EVEN Solutions Developers Team
+
+package multibase_test
+
+import (
+	stderrors "errors"
+	"reflect"
+	"testing"
+
+	"github.com/platsko/go-kit/base58"
+	"github.com/platsko/go-kit/bytes"
+	"github.com/platsko/go-kit/errors"
+	. "github.com/platsko/go-kit/multibase"
+)
+
+func Benchmark_Encode(b *testing.B) {
+	blob := bytes.RandBytes(32)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := Encode(Base58BTC, blob); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func Benchmark_Decode(b *testing.B) {
+	str, err := Encode(Base58BTC, bytes.RandBytes(32))
+	if err != nil {
+		b.Fatal(err)
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, _, err = Decode(str); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func Test_Encode(t *testing.T) {
+	t.Parallel()
+
+	data := []byte("yes mani !")
+	tests := [11]struct {
+		name    string
+		base    Base
+		want    string
+		wantErr error
+	}{
+		{name: "base16_OK", base: Base16, want: "f796573206d616e692021"},
+		{name: "base16upper_OK", base: Base16Upper, want: "F796573206D616E692021"},
+		{name: "base32_OK", base: Base32, want: "bpfsxgidnmfxgsibb"},
+		{name: "base32upper_OK", base: Base32Upper, want: "BPFSXGIDNMFXGSIBB"},
+		{name: "base58btc_OK", base: Base58BTC, want: "z7paNL19xttacUY"},
+		{name: "base58flickr_OK", base: Base58Flickr, want: "Z7Pznk19XTTzBtx"},
+		{name: "base64_OK", base: Base64, want: "meWVzIG1hbmkgIQ"},
+		{name: "base64pad_OK", base: Base64Pad, want: "MeWVzIG1hbmkgIQ=="},
+		{name: "base64url_OK", base: Base64URL, want: "ueWVzIG1hbmkgIQ"},
+		{name: "base64urlpad_OK", base: Base64URLPad, want: "UeWVzIG1hbmkgIQ=="},
+		{name: "unsupported_ERR", base: '!', wantErr: ErrUnsupportedBase()},
+	}
+
+	for idx := range tests {
+		test := tests[idx]
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := Encode(test.base, data)
+			if !errors.Is(err, test.wantErr) {
+				t.Errorf("Encode() error: %v | want: %v", err, test.wantErr)
+				return
+			}
+			if got != test.want {
+				t.Errorf("Encode() got: %v | want: %v", got, test.want)
+			}
+			if test.wantErr != nil {
+				return
+			}
+
+			base, blob, err := Decode(got)
+			if err != nil {
+				t.Errorf("Decode() error: %v | want: %v", err, nil)
+				return
+			}
+			if base != test.base || !reflect.DeepEqual(blob, data) {
+				t.Errorf("Decode() got: %v %q | want: %v %q", base, blob, test.base, data)
+			}
+		})
+	}
+}
+
+func Test_Decode(t *testing.T) {
+	t.Parallel()
+
+	tests := [6]struct {
+		name     string
+		str      string
+		want     []byte
+		wantBase Base
+		wantErr  error
+	}{
+		{
+			name:     "base32_mixed_case_OK",
+			str:      "bPFSXgidnmfxgsibb",
+			want:     []byte("yes mani !"),
+			wantBase: Base32,
+		},
+		{
+			name:     "base58btc_leading_zeros_OK",
+			str:      "z11",
+			want:     []byte{0, 0},
+			wantBase: Base58BTC,
+		},
+		{
+			name:     "prefix_only_OK",
+			str:      "f",
+			want:     []byte{},
+			wantBase: Base16,
+		},
+		{
+			name:    "empty_input_ERR",
+			wantErr: ErrEmptyInput(),
+		},
+		{
+			name:    "unsupported_base_ERR",
+			str:     "1abc",
+			wantErr: ErrUnsupportedBase(),
+		},
+		{
+			name:    "base58btc_format_ERR",
+			str:     "z0OIl",
+			wantErr: base58.ErrUnknownFormat(),
+		},
+	}
+
+	for idx := range tests {
+		test := tests[idx]
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			base, got, err := Decode(test.str)
+			if !errors.Is(err, test.wantErr) {
+				t.Errorf("Decode() error: %v | want: %v", err, test.wantErr)
+				return
+			}
+			if base != test.wantBase || !reflect.DeepEqual(got, test.want) && test.wantErr == nil {
+				t.Errorf("Decode() got: %v %v | want: %v %v", base, got, test.wantBase, test.want)
+			}
+		})
+	}
+}
+
+func Test_Decode_PositionError(t *testing.T) {
+	t.Parallel()
+
+	_, _, err := Decode("z12l3")
+
+	var posErr *base58.PositionError
+	if !stderrors.As(err, &posErr) {
+		t.Errorf("Decode() error: %#v | want: %T", err, posErr)
+		return
+	}
+	if posErr.Pos != 2 || posErr.Char != 'l' {
+		t.Errorf("Decode() error: %v %q | want: %v %q", posErr.Pos, posErr.Char, 2, 'l')
+	}
+}
+
+func Test_ParseBase(t *testing.T) {
+	t.Parallel()
+
+	for _, base := range GetBases() {
+		got, err := ParseBase(base.String())
+		if err != nil {
+			t.Errorf("ParseBase() error: %v | want: %v", err, nil)
+			continue
+		}
+		if got != base || !got.IsValid() {
+			t.Errorf("ParseBase() got: %v | want: %v", got, base)
+		}
+	}
+
+	if _, err := ParseBase("base1024"); !errors.Is(err, ErrUnsupportedBase()) {
+		t.Errorf("ParseBase() error: %v | want: %v", err, ErrUnsupportedBase())
+	}
+	if got := Base('!').String(); got != "unknown" {
+		t.Errorf("String() got: %v | want: %v", got, "unknown")
+	}
+}